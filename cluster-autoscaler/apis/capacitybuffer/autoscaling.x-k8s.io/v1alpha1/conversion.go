@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1beta1"
+)
+
+// ConversionDataAnnotationKey is the annotation holding the fields of a CapacityBuffer
+// that aren't valid in the version it's converted to, so that converting a buffer
+// between v1alpha1 and v1beta1 and back is lossless.
+const ConversionDataAnnotationKey = "autoscaling.x-k8s.io/conversion-data"
+
+// conversionData holds the fields of CapacityBuffers dropped in conversion.
+// +k8s:deepcopy-gen=false
+// +kubebuilder:object:generate=false
+type conversionData struct {
+	// ScalableRef of v1alpha1 buffers that set both PodTemplateRef and ScalableRef,
+	// which v1beta1 doesn't allow.
+	ScalableRef *v1beta1.ScalableRef `json:"scalableRef,omitempty"`
+	// Schedules of v1beta1 buffers, which v1alpha1 doesn't have.
+	Schedules []v1beta1.CapacityBufferSchedule `json:"schedules,omitempty"`
+	// ActiveSchedule of v1beta1 buffers, which v1alpha1 doesn't have.
+	ActiveSchedule string `json:"activeSchedule,omitempty"`
+	// Chunk counts and provisioning stats of v1beta1 buffers, which v1alpha1 doesn't have.
+	ProvisionedReplicas      *int32                      `json:"provisionedReplicas,omitempty"`
+	PendingReplicas          *int32                      `json:"pendingReplicas,omitempty"`
+	UnschedulableReplicas    *int32                      `json:"unschedulableReplicas,omitempty"`
+	NodeGroups               []v1beta1.NodeGroupReplicas `json:"nodeGroups,omitempty"`
+	LastProvisioningDuration *metav1.Duration            `json:"lastProvisioningDuration,omitempty"`
+}
+
+func (d *conversionData) empty() bool {
+	return d.ScalableRef == nil && len(d.Schedules) == 0 && d.ActiveSchedule == "" &&
+		d.ProvisionedReplicas == nil && d.PendingReplicas == nil && d.UnschedulableReplicas == nil &&
+		len(d.NodeGroups) == 0 && d.LastProvisioningDuration == nil
+}
+
+// getConversionData returns the conversion data kept in the annotations. It returns
+// false if there's no conversion data or it can't be parsed.
+func getConversionData(annotations map[string]string) (conversionData, bool) {
+	var data conversionData
+	raw, found := annotations[ConversionDataAnnotationKey]
+	if !found {
+		return data, false
+	}
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return data, false
+	}
+	return data, true
+}
+
+// setConversionData returns a copy of the annotations with the conversion data
+// replaced by the given one, or removed if it's empty.
+func setConversionData(annotations map[string]string, data conversionData) (map[string]string, error) {
+	var raw []byte
+	if !data.empty() {
+		var err error
+		if raw, err = json.Marshal(data); err != nil {
+			return nil, fmt.Errorf("failed to marshal %s annotation: %v", ConversionDataAnnotationKey, err)
+		}
+	}
+	var result map[string]string
+	for key, value := range annotations {
+		if key == ConversionDataAnnotationKey {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]string, len(annotations))
+		}
+		result[key] = value
+	}
+	if raw != nil {
+		if result == nil {
+			result = make(map[string]string, 1)
+		}
+		result[ConversionDataAnnotationKey] = string(raw)
+	}
+	return result, nil
+}
+
+// Convert_v1alpha1_CapacityBuffer_To_v1beta1_CapacityBuffer converts a v1alpha1 CapacityBuffer
+// to v1beta1, restoring the fields kept in the conversion data annotation. Buffers without
+// a provisioning strategy get the default one. Buffers referring to both a PodTemplate and
+// a scalable object keep the PodTemplate, the scalable object is moved to the conversion
+// data annotation. Annotations that can't be parsed are left in place.
+func Convert_v1alpha1_CapacityBuffer_To_v1beta1_CapacityBuffer(in *CapacityBuffer, out *v1beta1.CapacityBuffer, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_CapacityBuffer_To_v1beta1_CapacityBuffer(in, out, s); err != nil {
+		return err
+	}
+	if out.Spec.ProvisioningStrategy == nil {
+		strategy := v1beta1.ActiveProvisioningStrategy
+		out.Spec.ProvisioningStrategy = &strategy
+	}
+	restored, found := getConversionData(in.Annotations)
+	if found {
+		out.Spec.Schedules = restored.Schedules
+		out.Status.ActiveSchedule = restored.ActiveSchedule
+		out.Status.ProvisionedReplicas = restored.ProvisionedReplicas
+		out.Status.PendingReplicas = restored.PendingReplicas
+		out.Status.UnschedulableReplicas = restored.UnschedulableReplicas
+		out.Status.NodeGroups = restored.NodeGroups
+		out.Status.LastProvisioningDuration = restored.LastProvisioningDuration
+	}
+	var data conversionData
+	if out.Spec.PodTemplateRef != nil && out.Spec.ScalableRef != nil {
+		data.ScalableRef = out.Spec.ScalableRef
+		out.Spec.ScalableRef = nil
+	}
+	if !found && data.empty() {
+		return nil
+	}
+	// The generated conversion shares the annotations with the input.
+	annotations, err := setConversionData(in.Annotations, data)
+	if err != nil {
+		return err
+	}
+	out.Annotations = annotations
+	return nil
+}
+
+// Convert_v1beta1_CapacityBuffer_To_v1alpha1_CapacityBuffer converts a v1beta1 CapacityBuffer
+// to v1alpha1, restoring the fields kept in the conversion data annotation. Schedules and status
+// fields, which v1alpha1 doesn't have, are moved to the conversion data annotation. Annotations
+// that can't be parsed are left in place.
+func Convert_v1beta1_CapacityBuffer_To_v1alpha1_CapacityBuffer(in *v1beta1.CapacityBuffer, out *CapacityBuffer, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_CapacityBuffer_To_v1alpha1_CapacityBuffer(in, out, s); err != nil {
+		return err
+	}
+	restored, found := getConversionData(in.Annotations)
+	if found && restored.ScalableRef != nil {
+		out.Spec.ScalableRef = &ScalableRef{
+			APIGroup: restored.ScalableRef.APIGroup,
+			Kind:     restored.ScalableRef.Kind,
+			Name:     restored.ScalableRef.Name,
+		}
+	}
+	data := conversionData{
+		Schedules:                in.Spec.Schedules,
+		ActiveSchedule:           in.Status.ActiveSchedule,
+		ProvisionedReplicas:      in.Status.ProvisionedReplicas,
+		PendingReplicas:          in.Status.PendingReplicas,
+		UnschedulableReplicas:    in.Status.UnschedulableReplicas,
+		NodeGroups:               in.Status.NodeGroups,
+		LastProvisioningDuration: in.Status.LastProvisioningDuration,
+	}
+	if !found && data.empty() {
+		return nil
+	}
+	annotations, err := setConversionData(in.Annotations, data)
+	if err != nil {
+		return err
+	}
+	out.Annotations = annotations
+	return nil
+}
+
+// Convert_v1beta1_CapacityBufferSpec_To_v1alpha1_CapacityBufferSpec converts a v1beta1
+// CapacityBufferSpec to v1alpha1. Schedules are kept in the conversion data annotation
+// by the conversion of the CapacityBuffer.
+func Convert_v1beta1_CapacityBufferSpec_To_v1alpha1_CapacityBufferSpec(in *v1beta1.CapacityBufferSpec, out *CapacityBufferSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_CapacityBufferSpec_To_v1alpha1_CapacityBufferSpec(in, out, s)
+}
+
+// Convert_v1beta1_CapacityBufferStatus_To_v1alpha1_CapacityBufferStatus converts a v1beta1
+// CapacityBufferStatus to v1alpha1. The fields v1alpha1 doesn't have are kept in the
+// conversion data annotation by the conversion of the CapacityBuffer.
+func Convert_v1beta1_CapacityBufferStatus_To_v1alpha1_CapacityBufferStatus(in *v1beta1.CapacityBufferStatus, out *CapacityBufferStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CapacityBufferStatus_To_v1alpha1_CapacityBufferStatus(in, out, s)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1beta1"
+	"sigs.k8s.io/randfill"
+)
+
+const fuzzIterations = 1000
+
+func newScheme(t testing.TB) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+// fuzzerFuncs fill buffers the way the API server stores them: the provisioning
+// strategy is always defaulted, and v1beta1 buffers don't refer to both a
+// PodTemplate and a scalable object.
+func fuzzerFuncs(_ serializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(spec *CapacityBufferSpec, c randfill.Continue) {
+			c.FillNoCustom(spec)
+			if spec.ProvisioningStrategy == nil {
+				strategy := v1beta1.ActiveProvisioningStrategy
+				spec.ProvisioningStrategy = &strategy
+			}
+		},
+		func(spec *v1beta1.CapacityBufferSpec, c randfill.Continue) {
+			c.FillNoCustom(spec)
+			if spec.ProvisioningStrategy == nil {
+				strategy := v1beta1.ActiveProvisioningStrategy
+				spec.ProvisioningStrategy = &strategy
+			}
+			if spec.PodTemplateRef != nil {
+				spec.ScalableRef = nil
+			}
+		},
+	}
+}
+
+func roundTrip(t *testing.T, scheme *runtime.Scheme, seed int64) {
+	filler := fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, fuzzerFuncs), rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+	for _, pair := range []struct {
+		original, intermediate, result runtime.Object
+	}{
+		{&v1beta1.CapacityBuffer{}, &CapacityBuffer{}, &v1beta1.CapacityBuffer{}},
+		{&CapacityBuffer{}, &v1beta1.CapacityBuffer{}, &CapacityBuffer{}},
+		{&v1beta1.CapacityBufferList{}, &CapacityBufferList{}, &v1beta1.CapacityBufferList{}},
+		{&CapacityBufferList{}, &v1beta1.CapacityBufferList{}, &CapacityBufferList{}},
+	} {
+		filler.Fill(pair.original)
+		original := pair.original.DeepCopyObject()
+		if err := scheme.Convert(pair.original, pair.intermediate, nil); err != nil {
+			t.Fatalf("seed %d: failed to convert %T to %T: %v", seed, pair.original, pair.intermediate, err)
+		}
+		if err := scheme.Convert(pair.intermediate, pair.result, nil); err != nil {
+			t.Fatalf("seed %d: failed to convert %T to %T: %v", seed, pair.intermediate, pair.result, err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, pair.original) {
+			t.Fatalf("seed %d: conversion modified the input %T: %s", seed, original, diff.Diff(original, pair.original))
+		}
+		if !apiequality.Semantic.DeepEqual(original, pair.result) {
+			t.Fatalf("seed %d: %T changed in round trip: %s", seed, original, diff.Diff(original, pair.result))
+		}
+	}
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+	seed := time.Now().UnixNano()
+	for i := int64(0); i < fuzzIterations; i++ {
+		roundTrip(t, scheme, seed+i)
+	}
+}
+
+func FuzzConversionRoundTrip(f *testing.F) {
+	scheme := newScheme(f)
+	for seed := int64(0); seed < 10; seed++ {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		roundTrip(t, scheme, seed)
+	})
+}
+
+func TestConvertToV1beta1(t *testing.T) {
+	scheme := newScheme(t)
+	replicas := int32(3)
+	alpha := &CapacityBuffer{
+		ObjectMeta: metav1.ObjectMeta{Name: "buffer", Annotations: map[string]string{"foo": "bar"}},
+		Spec: CapacityBufferSpec{
+			PodTemplateRef: &LocalObjectRef{Name: "template"},
+			ScalableRef:    &ScalableRef{APIGroup: "apps", Kind: "Deployment", Name: "app"},
+			Replicas:       &replicas,
+		},
+	}
+
+	beta := &v1beta1.CapacityBuffer{}
+	if err := scheme.Convert(alpha, beta, nil); err != nil {
+		t.Fatal(err)
+	}
+	if beta.Spec.ProvisioningStrategy == nil || *beta.Spec.ProvisioningStrategy != v1beta1.ActiveProvisioningStrategy {
+		t.Errorf("provisioning strategy wasn't defaulted: %v", beta.Spec.ProvisioningStrategy)
+	}
+	if beta.Spec.PodTemplateRef == nil || beta.Spec.PodTemplateRef.Name != "template" || beta.Spec.ScalableRef != nil {
+		t.Errorf("unexpected refs: %v, %v", beta.Spec.PodTemplateRef, beta.Spec.ScalableRef)
+	}
+	want := map[string]string{
+		"foo":                       "bar",
+		ConversionDataAnnotationKey: `{"scalableRef":{"apiGroup":"apps","kind":"Deployment","name":"app"}}`,
+	}
+	if !apiequality.Semantic.DeepEqual(want, beta.Annotations) {
+		t.Errorf("unexpected annotations: %s", diff.Diff(want, beta.Annotations))
+	}
+	if alpha.Spec.ProvisioningStrategy != nil || alpha.Spec.ScalableRef == nil || len(alpha.Annotations) != 1 {
+		t.Errorf("conversion modified the input: %+v", alpha)
+	}
+
+	// Buffers with a single ref keep it.
+	alpha.Spec.PodTemplateRef = nil
+	beta = &v1beta1.CapacityBuffer{}
+	if err := scheme.Convert(alpha, beta, nil); err != nil {
+		t.Fatal(err)
+	}
+	if beta.Spec.ScalableRef == nil || beta.Spec.ScalableRef.Name != "app" {
+		t.Errorf("unexpected scalable ref: %v", beta.Spec.ScalableRef)
+	}
+	if _, found := beta.Annotations[ConversionDataAnnotationKey]; found {
+		t.Errorf("unexpected %s annotation", ConversionDataAnnotationKey)
+	}
+}
+
+func TestConvertSchedulesToV1alpha1(t *testing.T) {
+	scheme := newScheme(t)
+	replicas := int32(5)
+	strategy := v1beta1.ActiveProvisioningStrategy
+	beta := &v1beta1.CapacityBuffer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "buffer",
+			Annotations: map[string]string{
+				ConversionDataAnnotationKey: `{"scalableRef":{"apiGroup":"apps","kind":"Deployment","name":"app"}}`,
+			},
+		},
+		Spec: v1beta1.CapacityBufferSpec{
+			PodTemplateRef:       &v1beta1.LocalObjectRef{Name: "template"},
+			ProvisioningStrategy: &strategy,
+			Schedules: []v1beta1.CapacityBufferSchedule{{
+				Name:     "business-hours",
+				Schedule: "0 7 * * 1-5",
+				Duration: metav1.Duration{Duration: 10 * time.Hour},
+				TimeZone: "Europe/Warsaw",
+				Replicas: &replicas,
+			}},
+		},
+		Status: v1beta1.CapacityBufferStatus{ActiveSchedule: "business-hours"},
+	}
+
+	alpha := &CapacityBuffer{}
+	if err := scheme.Convert(beta, alpha, nil); err != nil {
+		t.Fatal(err)
+	}
+	if alpha.Spec.ScalableRef == nil || alpha.Spec.ScalableRef.Name != "app" {
+		t.Errorf("scalable ref wasn't restored: %v", alpha.Spec.ScalableRef)
+	}
+	want := map[string]string{
+		ConversionDataAnnotationKey: `{"schedules":[{"name":"business-hours","schedule":"0 7 * * 1-5","duration":"10h0m0s","timeZone":"Europe/Warsaw","replicas":5}],"activeSchedule":"business-hours"}`,
+	}
+	if !apiequality.Semantic.DeepEqual(want, alpha.Annotations) {
+		t.Errorf("unexpected annotations: %s", diff.Diff(want, alpha.Annotations))
+	}
+
+	// Buffers without schedules don't keep the annotation.
+	beta.Spec.Schedules = nil
+	beta.Status.ActiveSchedule = ""
+	alpha = &CapacityBuffer{}
+	if err := scheme.Convert(beta, alpha, nil); err != nil {
+		t.Fatal(err)
+	}
+	if alpha.Annotations != nil {
+		t.Errorf("unexpected annotations: %v", alpha.Annotations)
+	}
+}
@@ -19,6 +19,7 @@ limitations under the License.
 // +k8s:openapi-gen=true
 // +k8s:protobuf-gen=package
 // +k8s:prerelease-lifecycle-gen=true
+// +k8s:conversion-gen=k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1beta1
 // +kubebuilder:object:generate=true
 
 package v1alpha1
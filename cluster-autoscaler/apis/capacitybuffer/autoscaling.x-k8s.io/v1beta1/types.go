@@ -41,6 +41,11 @@ import (
 // +kubebuilder:printcolumn:name="Strategy",type="string",JSONPath=".spec.provisioningStrategy",description="The strategy to be used."
 // +kubebuilder:printcolumn:name="PodTemplate",type="string",JSONPath=".status.podTemplateRef.name",description="The name of the PodTemplate used."
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="The actual number of buffer chunks."
+// +kubebuilder:printcolumn:name="Provisioned",type="integer",JSONPath=".status.provisionedReplicas",description="The number of buffer chunks with capacity available."
+// +kubebuilder:printcolumn:name="Pending",type="integer",JSONPath=".status.pendingReplicas",description="The number of buffer chunks waiting for capacity."
+// +kubebuilder:printcolumn:name="Unschedulable",type="integer",JSONPath=".status.unschedulableReplicas",description="The number of buffer chunks that can't be provisioned."
+// +kubebuilder:printcolumn:name="NodeGroups",type="string",JSONPath=".status.nodeGroups[*].name",description="The node groups holding the buffer chunks.",priority=1
+// +kubebuilder:printcolumn:name="LastProvisioning",type="string",JSONPath=".status.lastProvisioningDuration",description="How long provisioning the buffer took last time.",priority=1
 // +kubebuilder:printcolumn:name="ConditionsType",type="string",JSONPath=".status.conditions[*].type",description="List of all condition types."
 // +kubebuilder:printcolumn:name="ConditionsStatus",type="string",JSONPath=".status.conditions[*].status",description="List of all condition statuses."
 // +kubebuilder:printcolumn:name="ConditionsReason",type="string",JSONPath=".status.conditions[*].reason",description="List of all condition reasons."
@@ -150,6 +155,66 @@ type CapacityBufferSpec struct {
 	// this will be used to create as many chunks as fit into these limits.
 	// +optional
 	Limits *ResourceList `json:"limits,omitempty" protobuf:"bytes,6,opt,name=limits"`
+
+	// Schedules change the size of the buffer at given times, e.g. to pre-warm capacity
+	// before weekday business hours. While an entry is active, its `replicas` and
+	// `percentage` replace the ones above, and the `limits` still apply. If the active
+	// periods of entries overlap, the entry that started last is in effect. Entries in
+	// the same time zone can't have the same schedule, other overlaps are allowed.
+	//
+	// The field is API-only for now: the buffer controller validates it, but ignores
+	// it when sizing the buffer.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(a, self.all(b, a.name == b.name || a.schedule != b.schedule || a.timeZone != b.timeZone))",message="Schedule entries in the same time zone can't have the same schedule"
+	Schedules []CapacityBufferSchedule `json:"schedules,omitempty" protobuf:"bytes,7,rep,name=schedules"`
+}
+
+// CapacityBufferSchedule is an entry of a buffer's schedule. It sizes the buffer for
+// a period starting at the times given by a cron expression.
+// +kubebuilder:validation:XValidation:rule="has(self.replicas) || has(self.percentage)",message="Schedule entries must set replicas or percentage"
+type CapacityBufferSchedule struct {
+	// Name of the entry, reported in the buffer's status while the entry is active.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Schedule is a cron expression in the standard five field format giving the times
+	// at which the entry becomes active, e.g. "0 7 * * 1-5" for 7:00 on weekdays. Fields
+	// are separated by single spaces, month and day of week names are uppercase, and
+	// macros such as "@daily" aren't supported.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^\S+ \S+ \S+ \S+ \S+$`
+	// +kubebuilder:validation:XValidation:rule="self.split(' ').size() == 5 && self.split(' ')[0].matches('^([*]|[1-5]?[0-9](-[1-5]?[0-9])?)(/[1-9][0-9]?)?(,([*]|[1-5]?[0-9](-[1-5]?[0-9])?)(/[1-9][0-9]?)?)*$') && self.split(' ')[1].matches('^([*]|(1?[0-9]|2[0-3])(-(1?[0-9]|2[0-3]))?)(/[1-9][0-9]?)?(,([*]|(1?[0-9]|2[0-3])(-(1?[0-9]|2[0-3]))?)(/[1-9][0-9]?)?)*$') && self.split(' ')[2].matches('^([*]|([1-9]|[12][0-9]|3[01])(-([1-9]|[12][0-9]|3[01]))?)(/[1-9][0-9]?)?(,([*]|([1-9]|[12][0-9]|3[01])(-([1-9]|[12][0-9]|3[01]))?)(/[1-9][0-9]?)?)*$') && self.split(' ')[3].matches('^([*]|([1-9]|1[0-2]|JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC)(-([1-9]|1[0-2]|JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC))?)(/[1-9][0-9]?)?(,([*]|([1-9]|1[0-2]|JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC)(-([1-9]|1[0-2]|JAN|FEB|MAR|APR|MAY|JUN|JUL|AUG|SEP|OCT|NOV|DEC))?)(/[1-9][0-9]?)?)*$') && self.split(' ')[4].matches('^([*]|([0-7]|SUN|MON|TUE|WED|THU|FRI|SAT)(-([0-7]|SUN|MON|TUE|WED|THU|FRI|SAT))?)(/[1-7])?(,([*]|([0-7]|SUN|MON|TUE|WED|THU|FRI|SAT)(-([0-7]|SUN|MON|TUE|WED|THU|FRI|SAT))?)(/[1-7])?)*$')",message="Schedule must be a cron expression with valid minute, hour, day of month, month and day of week fields"
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+
+	// Duration is how long the entry stays active after each start, at most a week.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s') && duration(self) <= duration('168h')",message="Duration must be positive and at most a week"
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+
+	// TimeZone is the name of the time zone of the schedule in the IANA Time Zone
+	// database, e.g. "Europe/Warsaw". Only the form of the name is validated, the API
+	// server can't check that the database contains it.
+	// +optional
+	// +kubebuilder:default="UTC"
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9_+-]*(/[A-Za-z0-9][A-Za-z0-9_+-]*)*$`
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
+
+	// Replicas replaces the buffer's `replicas` while the entry is active.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,5,opt,name=replicas"`
+
+	// Percentage replaces the buffer's `percentage` while the entry is active.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Percentage *int32 `json:"percentage,omitempty" protobuf:"varint,6,opt,name=percentage"`
 }
 
 // CapacityBufferStatus defines the observed state of CapacityBuffer.
@@ -182,8 +247,57 @@ type CapacityBufferStatus struct {
 	// ProvisioningStrategy defines how the buffer should be utilized.
 	// +optional
 	ProvisioningStrategy *string `json:"provisioningStrategy,omitempty" protobuf:"bytes,5,opt,name=provisioningStrategy"`
+	// ActiveSchedule is the name of the schedule entry the buffer is currently sized by.
+	// Empty if no entry is active.
+	//
+	// The field is API-only for now: the buffer controller doesn't set it.
+	// +optional
+	ActiveSchedule string `json:"activeSchedule,omitempty" protobuf:"bytes,6,opt,name=activeSchedule"`
+
+	// ProvisionedReplicas is the number of buffer chunks with capacity available for
+	// them in the cluster.
+	// +optional
+	ProvisionedReplicas *int32 `json:"provisionedReplicas,omitempty" protobuf:"varint,7,opt,name=provisionedReplicas"`
+
+	// PendingReplicas is the number of buffer chunks waiting for capacity, e.g. for
+	// nodes being provisioned for them.
+	// +optional
+	PendingReplicas *int32 `json:"pendingReplicas,omitempty" protobuf:"varint,8,opt,name=pendingReplicas"`
+
+	// UnschedulableReplicas is the number of buffer chunks that can't be provisioned,
+	// e.g. because no node group fits them or the node groups reached their limits.
+	// +optional
+	UnschedulableReplicas *int32 `json:"unschedulableReplicas,omitempty" protobuf:"varint,9,opt,name=unschedulableReplicas"`
+
+	// NodeGroups is the distribution of the provisioned buffer chunks over node groups.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	NodeGroups []NodeGroupReplicas `json:"nodeGroups,omitempty" protobuf:"bytes,10,rep,name=nodeGroups"`
+
+	// LastProvisioningDuration is how long it took the last time the buffer was resized
+	// until all of its chunks were provisioned or found unschedulable.
+	// +optional
+	LastProvisioningDuration *metav1.Duration `json:"lastProvisioningDuration,omitempty" protobuf:"bytes,11,opt,name=lastProvisioningDuration"`
+}
+
+// NodeGroupReplicas is the number of buffer chunks provisioned in a node group.
+type NodeGroupReplicas struct {
+	// Name of the node group, as reported by the cloud provider.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Replicas is the number of buffer chunks provisioned in the node group.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas" protobuf:"varint,2,opt,name=replicas"`
 }
 
+// ActiveProvisioningStrategy is the default provisioning strategy of buffers, where the
+// buffer actively scales up the cluster by creating placeholder pods.
+const ActiveProvisioningStrategy = "buffer.x-k8s.io/active-capacity"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // CapacityBufferList contains a list of CapacityBuffer objects.
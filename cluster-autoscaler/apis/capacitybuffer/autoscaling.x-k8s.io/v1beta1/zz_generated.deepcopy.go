@@ -84,6 +84,32 @@ func (in *CapacityBufferList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityBufferSchedule) DeepCopyInto(out *CapacityBufferSchedule) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityBufferSchedule.
+func (in *CapacityBufferSchedule) DeepCopy() *CapacityBufferSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityBufferSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CapacityBufferSpec) DeepCopyInto(out *CapacityBufferSpec) {
 	*out = *in
@@ -123,6 +149,13 @@ func (in *CapacityBufferSpec) DeepCopyInto(out *CapacityBufferSpec) {
 			}
 		}
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]CapacityBufferSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityBufferSpec.
@@ -165,6 +198,31 @@ func (in *CapacityBufferStatus) DeepCopyInto(out *CapacityBufferStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ProvisionedReplicas != nil {
+		in, out := &in.ProvisionedReplicas, &out.ProvisionedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PendingReplicas != nil {
+		in, out := &in.PendingReplicas, &out.PendingReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UnschedulableReplicas != nil {
+		in, out := &in.UnschedulableReplicas, &out.UnschedulableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodeGroups != nil {
+		in, out := &in.NodeGroups, &out.NodeGroups
+		*out = make([]NodeGroupReplicas, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastProvisioningDuration != nil {
+		in, out := &in.LastProvisioningDuration, &out.LastProvisioningDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityBufferStatus.
@@ -192,6 +250,21 @@ func (in *LocalObjectRef) DeepCopy() *LocalObjectRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupReplicas) DeepCopyInto(out *NodeGroupReplicas) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupReplicas.
+func (in *NodeGroupReplicas) DeepCopy() *NodeGroupReplicas {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupReplicas)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ResourceList) DeepCopyInto(out *ResourceList) {
 	{
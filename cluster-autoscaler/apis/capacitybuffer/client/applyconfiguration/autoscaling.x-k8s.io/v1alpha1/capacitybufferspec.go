@@ -41,13 +41,14 @@ type CapacityBufferSpecApplyConfiguration struct {
 	// Exactly one of `podTemplateRef`, `scalableRef` should be specified.
 	ScalableRef *ScalableRefApplyConfiguration `json:"scalableRef,omitempty"`
 	// Replicas defines the desired number of buffer chunks to provision.
-	// If neither `replicas` nor `percentage` is set, as many chunks as fit within
-	// defined resource limits (if any) will be created. If both are set, the maximum
-	// of the two will be used.
+	// The desired number is determined by taking the maximum of `replicas` and
+	// the computed replicas from `percentage` (whichever are defined), and is then capped
+	// by the defined resource `limits` (if defined). If neither `replicas` nor `percentage`
+	// is set, as many chunks as fit within the `limits` will be created.
 	Replicas *int32 `json:"replicas,omitempty"`
 	// Percentage defines the desired buffer capacity as a percentage of the
 	// `scalableRef`'s current replicas. This is only applicable if `scalableRef` is set.
-	// The absolute number of replicas is calculated from the percentage by rounding up to a minimum of 1.
+	// The absolute number of replicas is calculated from the percentage by rounding up to the nearest integer.
 	// For example, if `scalableRef` has 10 replicas and `percentage` is 20, 2 buffer chunks will be created.
 	Percentage *int32 `json:"percentage,omitempty"`
 	// Limits, if specified, will limit the number of chunks created for this buffer
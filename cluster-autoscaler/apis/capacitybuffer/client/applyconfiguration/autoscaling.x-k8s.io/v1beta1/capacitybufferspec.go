@@ -41,13 +41,14 @@ type CapacityBufferSpecApplyConfiguration struct {
 	// Exactly one of `podTemplateRef`, `scalableRef` should be specified.
 	ScalableRef *ScalableRefApplyConfiguration `json:"scalableRef,omitempty"`
 	// Replicas defines the desired number of buffer chunks to provision.
-	// If neither `replicas` nor `percentage` is set, as many chunks as fit within
-	// defined resource limits (if any) will be created. If both are set, the maximum
-	// of the two will be used.
+	// The desired number is determined by taking the maximum of `replicas` and
+	// the computed replicas from `percentage` (whichever are defined), and is then capped
+	// by the defined resource `limits` (if defined). If neither `replicas` nor `percentage`
+	// is set, as many chunks as fit within the `limits` will be created.
 	Replicas *int32 `json:"replicas,omitempty"`
 	// Percentage defines the desired buffer capacity as a percentage of the
 	// `scalableRef`'s current replicas. This is only applicable if `scalableRef` is set.
-	// The absolute number of replicas is calculated from the percentage by rounding up to a minimum of 1.
+	// The absolute number of replicas is calculated from the percentage by rounding up to the nearest integer.
 	// For example, if `scalableRef` has 10 replicas and `percentage` is 20, 2 buffer chunks will be created.
 	Percentage *int32 `json:"percentage,omitempty"`
 	// Limits, if specified, will limit the number of chunks created for this buffer
@@ -55,6 +56,15 @@ type CapacityBufferSpecApplyConfiguration struct {
 	// limitations for the number of chunks (i.e., `replicas` or `percentage` are not set),
 	// this will be used to create as many chunks as fit into these limits.
 	Limits *autoscalingxk8siov1beta1.ResourceList `json:"limits,omitempty"`
+	// Schedules change the size of the buffer at given times, e.g. to pre-warm capacity
+	// before weekday business hours. While an entry is active, its `replicas` and
+	// `percentage` replace the ones above, and the `limits` still apply. If the active
+	// periods of entries overlap, the entry that started last is in effect. Entries in
+	// the same time zone can't have the same schedule, other overlaps are allowed.
+	//
+	// The field is API-only for now: the buffer controller validates it, but ignores
+	// it when sizing the buffer.
+	Schedules []CapacityBufferScheduleApplyConfiguration `json:"schedules,omitempty"`
 }
 
 // CapacityBufferSpecApplyConfiguration constructs a declarative configuration of the CapacityBufferSpec type for use with
@@ -110,3 +120,16 @@ func (b *CapacityBufferSpecApplyConfiguration) WithLimits(value autoscalingxk8si
 	b.Limits = &value
 	return b
 }
+
+// WithSchedules adds the given value to the Schedules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Schedules field.
+func (b *CapacityBufferSpecApplyConfiguration) WithSchedules(values ...*CapacityBufferScheduleApplyConfiguration) *CapacityBufferSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSchedules")
+		}
+		b.Schedules = append(b.Schedules, *values[i])
+	}
+	return b
+}
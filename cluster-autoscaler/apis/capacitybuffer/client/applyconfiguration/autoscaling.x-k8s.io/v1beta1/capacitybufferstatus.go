@@ -19,6 +19,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -43,6 +44,25 @@ type CapacityBufferStatusApplyConfiguration struct {
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 	// ProvisioningStrategy defines how the buffer should be utilized.
 	ProvisioningStrategy *string `json:"provisioningStrategy,omitempty"`
+	// ActiveSchedule is the name of the schedule entry the buffer is currently sized by.
+	// Empty if no entry is active.
+	//
+	// The field is API-only for now: the buffer controller doesn't set it.
+	ActiveSchedule *string `json:"activeSchedule,omitempty"`
+	// ProvisionedReplicas is the number of buffer chunks with capacity available for
+	// them in the cluster.
+	ProvisionedReplicas *int32 `json:"provisionedReplicas,omitempty"`
+	// PendingReplicas is the number of buffer chunks waiting for capacity, e.g. for
+	// nodes being provisioned for them.
+	PendingReplicas *int32 `json:"pendingReplicas,omitempty"`
+	// UnschedulableReplicas is the number of buffer chunks that can't be provisioned,
+	// e.g. because no node group fits them or the node groups reached their limits.
+	UnschedulableReplicas *int32 `json:"unschedulableReplicas,omitempty"`
+	// NodeGroups is the distribution of the provisioned buffer chunks over node groups.
+	NodeGroups []NodeGroupReplicasApplyConfiguration `json:"nodeGroups,omitempty"`
+	// LastProvisioningDuration is how long it took the last time the buffer was resized
+	// until all of its chunks were provisioned or found unschedulable.
+	LastProvisioningDuration *metav1.Duration `json:"lastProvisioningDuration,omitempty"`
 }
 
 // CapacityBufferStatusApplyConfiguration constructs a declarative configuration of the CapacityBufferStatus type for use with
@@ -95,3 +115,56 @@ func (b *CapacityBufferStatusApplyConfiguration) WithProvisioningStrategy(value
 	b.ProvisioningStrategy = &value
 	return b
 }
+
+// WithActiveSchedule sets the ActiveSchedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveSchedule field is set to the value of the last call.
+func (b *CapacityBufferStatusApplyConfiguration) WithActiveSchedule(value string) *CapacityBufferStatusApplyConfiguration {
+	b.ActiveSchedule = &value
+	return b
+}
+
+// WithProvisionedReplicas sets the ProvisionedReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProvisionedReplicas field is set to the value of the last call.
+func (b *CapacityBufferStatusApplyConfiguration) WithProvisionedReplicas(value int32) *CapacityBufferStatusApplyConfiguration {
+	b.ProvisionedReplicas = &value
+	return b
+}
+
+// WithPendingReplicas sets the PendingReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PendingReplicas field is set to the value of the last call.
+func (b *CapacityBufferStatusApplyConfiguration) WithPendingReplicas(value int32) *CapacityBufferStatusApplyConfiguration {
+	b.PendingReplicas = &value
+	return b
+}
+
+// WithUnschedulableReplicas sets the UnschedulableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnschedulableReplicas field is set to the value of the last call.
+func (b *CapacityBufferStatusApplyConfiguration) WithUnschedulableReplicas(value int32) *CapacityBufferStatusApplyConfiguration {
+	b.UnschedulableReplicas = &value
+	return b
+}
+
+// WithNodeGroups adds the given value to the NodeGroups field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NodeGroups field.
+func (b *CapacityBufferStatusApplyConfiguration) WithNodeGroups(values ...*NodeGroupReplicasApplyConfiguration) *CapacityBufferStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodeGroups")
+		}
+		b.NodeGroups = append(b.NodeGroups, *values[i])
+	}
+	return b
+}
+
+// WithLastProvisioningDuration sets the LastProvisioningDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastProvisioningDuration field is set to the value of the last call.
+func (b *CapacityBufferStatusApplyConfiguration) WithLastProvisioningDuration(value metav1.Duration) *CapacityBufferStatusApplyConfiguration {
+	b.LastProvisioningDuration = &value
+	return b
+}
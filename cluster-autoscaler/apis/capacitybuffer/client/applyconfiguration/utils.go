@@ -48,12 +48,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=autoscaling.x-k8s.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithKind("CapacityBuffer"):
 		return &autoscalingxk8siov1beta1.CapacityBufferApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityBufferSchedule"):
+		return &autoscalingxk8siov1beta1.CapacityBufferScheduleApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("CapacityBufferSpec"):
 		return &autoscalingxk8siov1beta1.CapacityBufferSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("CapacityBufferStatus"):
 		return &autoscalingxk8siov1beta1.CapacityBufferStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("LocalObjectRef"):
 		return &autoscalingxk8siov1beta1.LocalObjectRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("NodeGroupReplicas"):
+		return &autoscalingxk8siov1beta1.NodeGroupReplicasApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ScalableRef"):
 		return &autoscalingxk8siov1beta1.ScalableRefApplyConfiguration{}
 
@@ -41,6 +41,36 @@ const (
 	ReconciliationSucceeded = "ReconciliationSucceeded"
 	// ReconciliationFailed specifies that the CapacityQuota status has failed to reconcile.
 	ReconciliationFailed = "ReconciliationFailed"
+	// BudgetExhaustedCondition is the condition specifying whether the budget of the CapacityQuota has been used up in the current window.
+	BudgetExhaustedCondition = "BudgetExhausted"
+	// BudgetAvailable specifies that the budget of the CapacityQuota hasn't been used up in the current window.
+	BudgetAvailable = "BudgetAvailable"
+	// BudgetLimitReached specifies that the budget of the CapacityQuota has been used up in the current window.
+	BudgetLimitReached = "BudgetLimitReached"
+)
+
+// BudgetResetPolicy defines how the consumption of a budget is reset.
+// +kubebuilder:validation:Enum=Rolling;Calendar
+type BudgetResetPolicy string
+
+const (
+	// RollingBudgetResetPolicy counts the consumption over the window preceding the current time.
+	RollingBudgetResetPolicy BudgetResetPolicy = "Rolling"
+	// CalendarBudgetResetPolicy resets the consumption at the start of each calendar period.
+	CalendarBudgetResetPolicy BudgetResetPolicy = "Calendar"
+)
+
+// BudgetPeriod is a calendar period after which the consumption of a budget is reset.
+// +kubebuilder:validation:Enum=Day;Week;Month
+type BudgetPeriod string
+
+const (
+	// DayBudgetPeriod resets the consumption at midnight.
+	DayBudgetPeriod BudgetPeriod = "Day"
+	// WeekBudgetPeriod resets the consumption at midnight on Monday.
+	WeekBudgetPeriod BudgetPeriod = "Week"
+	// MonthBudgetPeriod resets the consumption at midnight on the first day of the month.
+	MonthBudgetPeriod BudgetPeriod = "Month"
 )
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -85,11 +115,43 @@ type CapacityQuotaSpec struct {
 	// +optional
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 
+	// Scope restricts the quota to the capacity provisioned for pods from the given
+	// namespaces or priority classes. Capacity of a node is attributed to the pods it
+	// was provisioned for, in proportion to their resource requests. Unscoped quotas
+	// limit all capacity of the selected nodes.
+	// This field is API-only: node autoscaler doesn't attribute capacity to pods yet,
+	// so it ignores quotas with a scope and they don't limit anything.
+	// +optional
+	Scope *CapacityQuotaScope `json:"scope,omitempty"`
+
 	// Limits define quota limits.
 	// +required
 	Limits CapacityQuotaLimits `json:"limits"`
 }
 
+// CapacityQuotaScope selects the pods whose capacity is limited by a quota. A pod is
+// in scope if it matches all the set fields.
+// +kubebuilder:validation:XValidation:rule="has(self.namespaces) || has(self.priorityClassNames)",message="Scope must set namespaces or priorityClassNames."
+type CapacityQuotaScope struct {
+	// Namespaces of the pods in scope.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=63
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// PriorityClassNames of the pods in scope. Pods without a priority class are
+	// matched by an empty name.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MaxLength=253
+	PriorityClassNames []string `json:"priorityClassNames,omitempty"`
+}
+
 // CapacityQuotaLimits define quota limits.
 type CapacityQuotaLimits struct {
 	// Resources define resource limits of this quota.
@@ -106,12 +168,63 @@ type CapacityQuotaLimits struct {
 	//
 	// Node autoscaler implementations and cloud providers can support custom
 	// resources, such as GPU.
+	//
+	// Quotas that only limit a budget set resources to {}.
+	// +required
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:MaxProperties=20
+	// +kubebuilder:validation:XValidation:rule="self.all(key, size(key) <= 63)",message="Resource names must be 63 characters or less."
+	// +kubebuilder:validation:XValidation:rule="self.all(key, type(self[key]) == int ? self[key] >= 0 : (!self[key].startsWith('-') && quantity(self[key]).isInteger()))",message="All resource quantities must be non-negative integers."
+	Resources ResourceList `json:"resources"`
+
+	// Budget limits the consumption of resources over time, on top of the limits
+	// of instantaneous usage defined in resources.
+	// +optional
+	Budget *CapacityQuotaBudget `json:"budget,omitempty"`
+}
+
+// CapacityQuotaBudget limits the consumption of resources over a time window. The
+// intent is that node autoscaler doesn't provision nodes matching the quota while its
+// budget is exhausted, and reports that in the BudgetExhausted condition. Neither the
+// consumption accounting nor the enforcement are implemented yet, so budgets only
+// have an effect once a node autoscaler implements them.
+// +kubebuilder:validation:XValidation:rule="self.resetPolicy == 'Rolling' ? has(self.window) && !has(self.period) : has(self.period) && !has(self.window)",message="Rolling budgets must set window and calendar budgets must set period."
+// +kubebuilder:validation:XValidation:rule="self.resetPolicy == 'Calendar' || !has(self.timeZone)",message="Only calendar budgets can set timeZone."
+type CapacityQuotaBudget struct {
+	// Resources define the budget of each resource in resource-hours: the capacity
+	// of the nodes matching the quota multiplied by the time they are provisioned.
+	// For example, "nvidia.com/gpu: 5000" allows 5000 GPU-hours and "nodes: 100"
+	// allows 100 node-hours in each window. The same quantities as in the limits
+	// of instantaneous usage are allowed.
 	// +required
 	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:MinProperties=1
 	// +kubebuilder:validation:MaxProperties=20
 	// +kubebuilder:validation:XValidation:rule="self.all(key, size(key) <= 63)",message="Resource names must be 63 characters or less."
 	// +kubebuilder:validation:XValidation:rule="self.all(key, type(self[key]) == int ? self[key] >= 0 : (!self[key].startsWith('-') && quantity(self[key]).isInteger()))",message="All resource quantities must be non-negative integers."
 	Resources ResourceList `json:"resources"`
+
+	// ResetPolicy defines the window over which the consumption is counted. Rolling
+	// budgets count the consumption over the last window, in buckets of a 24th of the
+	// window, and calendar budgets reset it at the start of each period.
+	// +required
+	ResetPolicy BudgetResetPolicy `json:"resetPolicy"`
+
+	// Window is the length of the window of rolling budgets, at most 31 days.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1h') && duration(self) <= duration('744h')",message="Window must be between 1h and 744h."
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// Period is the calendar period of calendar budgets.
+	// +optional
+	Period BudgetPeriod `json:"period,omitempty"`
+
+	// TimeZone is the name of the time zone of the calendar periods in the IANA Time
+	// Zone database, e.g. "America/New_York". Defaults to UTC.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_+/-]+$`
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 // CapacityQuotaStatus defines the observed state of CapacityQuota.
@@ -136,12 +249,75 @@ type CapacityQuotaStatus struct {
 	// ObservedGeneration is the last generation observed by the controller.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ScopedUsage breaks the usage of scoped quotas down by namespace and priority
+	// class of the pods the capacity is attributed to, with at most one entry per
+	// namespace and priority class. When there are more, only the largest are listed.
+	// This field is API-only: node autoscaler doesn't attribute capacity to pods yet,
+	// so it isn't set until an implementation does.
+	// +optional
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=priorityClassName
+	// +kubebuilder:validation:MaxItems=256
+	ScopedUsage []CapacityQuotaScopedUsage `json:"scopedUsage,omitempty"`
+}
+
+// CapacityQuotaScopedUsage shows the usage of a quota attributed to pods from a
+// namespace with a priority class.
+type CapacityQuotaScopedUsage struct {
+	// Namespace of the pods.
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+
+	// PriorityClassName of the pods, empty for pods without a priority class.
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	PriorityClassName string `json:"priorityClassName"`
+
+	// Resources shows the usage of the resources defined in the quota limits.
+	Resources ResourceList `json:"resources"`
 }
 
 // CapacityQuotaUsage shows the current usage of the quota.
 type CapacityQuotaUsage struct {
 	// Resources shows the current usage of the resources defined in the quota limits.
 	Resources ResourceList `json:"resources"`
+
+	// Budget shows the consumption of the quota budget in the current window.
+	// +optional
+	Budget *CapacityQuotaBudgetUsage `json:"budget,omitempty"`
+}
+
+// CapacityQuotaBudgetUsage shows the consumption of the quota budget in the current window.
+type CapacityQuotaBudgetUsage struct {
+	// Resources shows the consumption of the resources defined in the quota budget,
+	// in resource-hours.
+	Resources ResourceList `json:"resources"`
+
+	// WindowStart is the start of the current window. For rolling budgets, it is the
+	// start of the oldest bucket.
+	WindowStart metav1.Time `json:"windowStart"`
+
+	// Buckets break the consumption of rolling budgets down into consecutive intervals
+	// of a 24th of the window, oldest first, so that the consumption of the oldest
+	// interval can be dropped from resources as the window moves on. Empty for
+	// calendar budgets.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=25
+	Buckets []CapacityQuotaBudgetBucket `json:"buckets,omitempty"`
+}
+
+// CapacityQuotaBudgetBucket shows the consumption of a rolling budget in an interval.
+type CapacityQuotaBudgetBucket struct {
+	// Start is the start of the interval.
+	Start metav1.Time `json:"start"`
+
+	// Resources shows the consumption of the resources defined in the quota budget
+	// during the interval, in resource-hours.
+	Resources ResourceList `json:"resources"`
 }
 
 // +kubebuilder:object:root=true
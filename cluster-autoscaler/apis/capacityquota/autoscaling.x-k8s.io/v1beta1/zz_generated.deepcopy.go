@@ -52,6 +52,86 @@ func (in *CapacityQuota) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityQuotaBudget) DeepCopyInto(out *CapacityQuotaBudget) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaBudget.
+func (in *CapacityQuotaBudget) DeepCopy() *CapacityQuotaBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityQuotaBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityQuotaBudgetBucket) DeepCopyInto(out *CapacityQuotaBudgetBucket) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaBudgetBucket.
+func (in *CapacityQuotaBudgetBucket) DeepCopy() *CapacityQuotaBudgetBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityQuotaBudgetBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityQuotaBudgetUsage) DeepCopyInto(out *CapacityQuotaBudgetUsage) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	in.WindowStart.DeepCopyInto(&out.WindowStart)
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]CapacityQuotaBudgetBucket, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaBudgetUsage.
+func (in *CapacityQuotaBudgetUsage) DeepCopy() *CapacityQuotaBudgetUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityQuotaBudgetUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CapacityQuotaLimits) DeepCopyInto(out *CapacityQuotaLimits) {
 	*out = *in
@@ -62,6 +142,11 @@ func (in *CapacityQuotaLimits) DeepCopyInto(out *CapacityQuotaLimits) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(CapacityQuotaBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaLimits.
@@ -106,6 +191,53 @@ func (in *CapacityQuotaList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityQuotaScope) DeepCopyInto(out *CapacityQuotaScope) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PriorityClassNames != nil {
+		in, out := &in.PriorityClassNames, &out.PriorityClassNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaScope.
+func (in *CapacityQuotaScope) DeepCopy() *CapacityQuotaScope {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityQuotaScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityQuotaScopedUsage) DeepCopyInto(out *CapacityQuotaScopedUsage) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaScopedUsage.
+func (in *CapacityQuotaScopedUsage) DeepCopy() *CapacityQuotaScopedUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityQuotaScopedUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CapacityQuotaSpec) DeepCopyInto(out *CapacityQuotaSpec) {
 	*out = *in
@@ -114,6 +246,11 @@ func (in *CapacityQuotaSpec) DeepCopyInto(out *CapacityQuotaSpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(CapacityQuotaScope)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Limits.DeepCopyInto(&out.Limits)
 }
 
@@ -142,6 +279,13 @@ func (in *CapacityQuotaStatus) DeepCopyInto(out *CapacityQuotaStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ScopedUsage != nil {
+		in, out := &in.ScopedUsage, &out.ScopedUsage
+		*out = make([]CapacityQuotaScopedUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaStatus.
@@ -164,6 +308,11 @@ func (in *CapacityQuotaUsage) DeepCopyInto(out *CapacityQuotaUsage) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(CapacityQuotaBudgetUsage)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityQuotaUsage.
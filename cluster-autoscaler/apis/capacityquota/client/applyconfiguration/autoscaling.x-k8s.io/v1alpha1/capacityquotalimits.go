@@ -41,7 +41,12 @@ type CapacityQuotaLimitsApplyConfiguration struct {
 	//
 	// Node autoscaler implementations and cloud providers can support custom
 	// resources, such as GPU.
+	//
+	// Quotas that only limit a budget set resources to {}.
 	Resources *autoscalingxk8siov1alpha1.ResourceList `json:"resources,omitempty"`
+	// Budget limits the consumption of resources over time, on top of the limits
+	// of instantaneous usage defined in resources.
+	Budget *CapacityQuotaBudgetApplyConfiguration `json:"budget,omitempty"`
 }
 
 // CapacityQuotaLimitsApplyConfiguration constructs a declarative configuration of the CapacityQuotaLimits type for use with
@@ -57,3 +62,11 @@ func (b *CapacityQuotaLimitsApplyConfiguration) WithResources(value autoscalingx
 	b.Resources = &value
 	return b
 }
+
+// WithBudget sets the Budget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budget field is set to the value of the last call.
+func (b *CapacityQuotaLimitsApplyConfiguration) WithBudget(value *CapacityQuotaBudgetApplyConfiguration) *CapacityQuotaLimitsApplyConfiguration {
+	b.Budget = value
+	return b
+}
@@ -30,6 +30,13 @@ type CapacityQuotaSpecApplyConfiguration struct {
 	// Selector is a label selector selecting the nodes to which the quota applies.
 	// Empty or nil selector matches all nodes.
 	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// Scope restricts the quota to the capacity provisioned for pods from the given
+	// namespaces or priority classes. Capacity of a node is attributed to the pods it
+	// was provisioned for, in proportion to their resource requests. Unscoped quotas
+	// limit all capacity of the selected nodes.
+	// This field is API-only: node autoscaler doesn't attribute capacity to pods yet,
+	// so it ignores quotas with a scope and they don't limit anything.
+	Scope *CapacityQuotaScopeApplyConfiguration `json:"scope,omitempty"`
 	// Limits define quota limits.
 	Limits *CapacityQuotaLimitsApplyConfiguration `json:"limits,omitempty"`
 }
@@ -48,6 +55,14 @@ func (b *CapacityQuotaSpecApplyConfiguration) WithSelector(value *v1.LabelSelect
 	return b
 }
 
+// WithScope sets the Scope field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scope field is set to the value of the last call.
+func (b *CapacityQuotaSpecApplyConfiguration) WithScope(value *CapacityQuotaScopeApplyConfiguration) *CapacityQuotaSpecApplyConfiguration {
+	b.Scope = value
+	return b
+}
+
 // WithLimits sets the Limits field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Limits field is set to the value of the last call.
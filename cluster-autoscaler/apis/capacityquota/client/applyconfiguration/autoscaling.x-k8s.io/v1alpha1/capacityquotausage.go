@@ -29,6 +29,8 @@ import (
 type CapacityQuotaUsageApplyConfiguration struct {
 	// Resources shows the current usage of the resources defined in the quota limits.
 	Resources *autoscalingxk8siov1alpha1.ResourceList `json:"resources,omitempty"`
+	// Budget shows the consumption of the quota budget in the current window.
+	Budget *CapacityQuotaBudgetUsageApplyConfiguration `json:"budget,omitempty"`
 }
 
 // CapacityQuotaUsageApplyConfiguration constructs a declarative configuration of the CapacityQuotaUsage type for use with
@@ -44,3 +46,11 @@ func (b *CapacityQuotaUsageApplyConfiguration) WithResources(value autoscalingxk
 	b.Resources = &value
 	return b
 }
+
+// WithBudget sets the Budget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Budget field is set to the value of the last call.
+func (b *CapacityQuotaUsageApplyConfiguration) WithBudget(value *CapacityQuotaBudgetUsageApplyConfiguration) *CapacityQuotaUsageApplyConfiguration {
+	b.Budget = value
+	return b
+}
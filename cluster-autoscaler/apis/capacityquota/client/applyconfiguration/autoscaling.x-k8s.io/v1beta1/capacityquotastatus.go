@@ -37,6 +37,12 @@ type CapacityQuotaStatusApplyConfiguration struct {
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 	// ObservedGeneration is the last generation observed by the controller.
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// ScopedUsage breaks the usage of scoped quotas down by namespace and priority
+	// class of the pods the capacity is attributed to, with at most one entry per
+	// namespace and priority class. When there are more, only the largest are listed.
+	// This field is API-only: node autoscaler doesn't attribute capacity to pods yet,
+	// so it isn't set until an implementation does.
+	ScopedUsage []CapacityQuotaScopedUsageApplyConfiguration `json:"scopedUsage,omitempty"`
 }
 
 // CapacityQuotaStatusApplyConfiguration constructs a declarative configuration of the CapacityQuotaStatus type for use with
@@ -73,3 +79,16 @@ func (b *CapacityQuotaStatusApplyConfiguration) WithObservedGeneration(value int
 	b.ObservedGeneration = &value
 	return b
 }
+
+// WithScopedUsage adds the given value to the ScopedUsage field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ScopedUsage field.
+func (b *CapacityQuotaStatusApplyConfiguration) WithScopedUsage(values ...*CapacityQuotaScopedUsageApplyConfiguration) *CapacityQuotaStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithScopedUsage")
+		}
+		b.ScopedUsage = append(b.ScopedUsage, *values[i])
+	}
+	return b
+}
@@ -36,8 +36,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=autoscaling.x-k8s.io, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuota"):
 		return &autoscalingxk8siov1alpha1.CapacityQuotaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaBudget"):
+		return &autoscalingxk8siov1alpha1.CapacityQuotaBudgetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaBudgetBucket"):
+		return &autoscalingxk8siov1alpha1.CapacityQuotaBudgetBucketApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaBudgetUsage"):
+		return &autoscalingxk8siov1alpha1.CapacityQuotaBudgetUsageApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaLimits"):
 		return &autoscalingxk8siov1alpha1.CapacityQuotaLimitsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaScope"):
+		return &autoscalingxk8siov1alpha1.CapacityQuotaScopeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaScopedUsage"):
+		return &autoscalingxk8siov1alpha1.CapacityQuotaScopedUsageApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaSpec"):
 		return &autoscalingxk8siov1alpha1.CapacityQuotaSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CapacityQuotaStatus"):
@@ -48,8 +58,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=autoscaling.x-k8s.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuota"):
 		return &autoscalingxk8siov1beta1.CapacityQuotaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaBudget"):
+		return &autoscalingxk8siov1beta1.CapacityQuotaBudgetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaBudgetBucket"):
+		return &autoscalingxk8siov1beta1.CapacityQuotaBudgetBucketApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaBudgetUsage"):
+		return &autoscalingxk8siov1beta1.CapacityQuotaBudgetUsageApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaLimits"):
 		return &autoscalingxk8siov1beta1.CapacityQuotaLimitsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaScope"):
+		return &autoscalingxk8siov1beta1.CapacityQuotaScopeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaScopedUsage"):
+		return &autoscalingxk8siov1beta1.CapacityQuotaScopedUsageApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaSpec"):
 		return &autoscalingxk8siov1beta1.CapacityQuotaSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("CapacityQuotaStatus"):
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// conversion-webhook serves the conversion webhook of the CustomResourceDefinitions
+// of this module that have more than one version with different schemas.
+//
+// The manifests in config/conversion-webhook deploy it together with its Service
+// and the CustomResourceDefinitions of config/crd patched to use it.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	cbv1alpha1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1alpha1"
+	cbv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1beta1"
+	"k8s.io/autoscaler/cluster-autoscaler/apis/conversion"
+	provreqv1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	provreqv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1beta1"
+	"k8s.io/klog/v2"
+)
+
+var (
+	address  = flag.String("address", ":9443", "The address the webhook listens on.")
+	certFile = flag.String("tls-cert-file", "/etc/conversion-webhook/tls/tls.crt", "Path to the serving certificate.")
+	keyFile  = flag.String("tls-private-key-file", "/etc/conversion-webhook/tls/tls.key", "Path to the key of the serving certificate.")
+)
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(provreqv1.AddToScheme(scheme))
+	utilruntime.Must(provreqv1beta1.AddToScheme(scheme))
+	utilruntime.Must(cbv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(cbv1beta1.AddToScheme(scheme))
+	return scheme
+}
+
+func main() {
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	server := &conversion.Server{Addr: *address, CertFile: *certFile, KeyFile: *keyFile}
+	klog.Infof("Serving conversion webhook on %s", *address)
+	if err := server.Run(ctx, conversion.NewWebhook(newScheme())); err != nil {
+		klog.Fatalf("Failed to serve conversion webhook: %v", err)
+	}
+}
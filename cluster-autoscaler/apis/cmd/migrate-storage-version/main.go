@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// migrate-storage-version rewrites all objects of a CustomResourceDefinition in
+// its current storage version, and then removes the other versions from the
+// stored versions of the CustomResourceDefinition, so they can stop being served.
+//
+// For example, after upgrading the CapacityBuffer CRD to store v1beta1:
+//
+//	go run ./cmd/migrate-storage-version --crd=capacitybuffers.autoscaling.x-k8s.io
+//
+// Objects are converted by the API server, so the conversion webhook of the
+// CustomResourceDefinition (see config/conversion-webhook) has to be running.
+// CustomResourceDefinitions without a conversion webhook are only migrated with
+// --allow-none-conversion, as their objects would be stored without converting
+// their fields. Migrating is idempotent and can be retried if it fails.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+)
+
+var (
+	kubeconfig = flag.String("kubeconfig", "", "Path to the kubeconfig file. In-cluster configuration is used if empty.")
+	crdName    = flag.String("crd", "capacitybuffers.autoscaling.x-k8s.io", "Name of the CustomResourceDefinition whose objects are migrated.")
+	pageSize   = flag.Int64("page-size", 500, "Number of objects listed at once.")
+
+	allowNoneConversion = flag.Bool("allow-none-conversion", false, "Migrate the CustomResourceDefinition even if it doesn't use a conversion webhook. Only safe if its versions have the same schema.")
+)
+
+func main() {
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	if err != nil {
+		klog.Fatalf("Failed to build client config: %v", err)
+	}
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		klog.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	m := &migrator{client: client, pageSize: *pageSize, allowNoneConversion: *allowNoneConversion}
+	if err := m.migrate(ctx, *crdName); err != nil {
+		klog.Fatalf("Failed to migrate %s: %v", *crdName, err)
+	}
+}
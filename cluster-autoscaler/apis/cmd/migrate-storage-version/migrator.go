@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+var crdResource = apiextensionsv1.SchemeGroupVersion.WithResource("customresourcedefinitions")
+
+// migrator rewrites the objects of CustomResourceDefinitions in their storage version.
+type migrator struct {
+	client   dynamic.Interface
+	pageSize int64
+	// allowNoneConversion allows migrating CustomResourceDefinitions without a
+	// conversion webhook, whose versions only differ in their names.
+	allowNoneConversion bool
+}
+
+// migrate rewrites all objects of the CustomResourceDefinition and sets its stored
+// versions to the storage version.
+func (m *migrator) migrate(ctx context.Context, name string) error {
+	crd, err := m.getCRD(ctx, name)
+	if err != nil {
+		return err
+	}
+	storageVersion := ""
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+		}
+	}
+	if storageVersion == "" {
+		return fmt.Errorf("no storage version in %s", name)
+	}
+	if !m.allowNoneConversion && (crd.Spec.Conversion == nil || crd.Spec.Conversion.Strategy != apiextensionsv1.WebhookConverter) {
+		// Without a webhook, the API server only changes the apiVersion of the
+		// objects, which would store them without converting their fields.
+		return fmt.Errorf("%s doesn't use a conversion webhook, objects would be stored in %s without being converted", name, storageVersion)
+	}
+	if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storageVersion {
+		klog.Infof("All objects of %s are already stored in %s", name, storageVersion)
+		return nil
+	}
+
+	resource := schema.GroupVersionResource{Group: crd.Spec.Group, Version: storageVersion, Resource: crd.Spec.Names.Plural}
+	count, err := m.rewriteAll(ctx, resource)
+	if err != nil {
+		return fmt.Errorf("failed to rewrite %s: %v", resource, err)
+	}
+	klog.Infof("Rewrote %d objects of %s in %s", count, name, storageVersion)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd, err := m.getCRD(ctx, name)
+		if err != nil {
+			return err
+		}
+		crd.Status.StoredVersions = []string{storageVersion}
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(crd)
+		if err != nil {
+			return err
+		}
+		_, err = m.client.Resource(crdResource).UpdateStatus(ctx, &unstructured.Unstructured{Object: object}, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (m *migrator) getCRD(ctx context.Context, name string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	object, err := m.client.Resource(crdResource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CustomResourceDefinition %s: %v", name, err)
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, crd); err != nil {
+		return nil, fmt.Errorf("failed to parse CustomResourceDefinition %s: %v", name, err)
+	}
+	return crd, nil
+}
+
+// rewriteAll updates all objects of the resource without changing them, which
+// makes the API server store them in the storage version.
+func (m *migrator) rewriteAll(ctx context.Context, resource schema.GroupVersionResource) (int, error) {
+	count := 0
+	options := metav1.ListOptions{Limit: m.pageSize}
+	for {
+		list, err := m.client.Resource(resource).List(ctx, options)
+		if err != nil {
+			return count, err
+		}
+		for i := range list.Items {
+			if err := m.rewrite(ctx, resource, &list.Items[i]); err != nil {
+				return count, fmt.Errorf("failed to rewrite %s/%s: %v", list.Items[i].GetNamespace(), list.Items[i].GetName(), err)
+			}
+			count++
+		}
+		options.Continue = list.GetContinue()
+		if options.Continue == "" {
+			return count, nil
+		}
+	}
+}
+
+func (m *migrator) rewrite(ctx context.Context, resource schema.GroupVersionResource, object *unstructured.Unstructured) error {
+	client := m.client.Resource(resource).Namespace(object.GetNamespace())
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := client.Update(ctx, object, metav1.UpdateOptions{})
+		if apierrors.IsConflict(err) {
+			latest, getErr := client.Get(ctx, object.GetName(), metav1.GetOptions{})
+			if getErr != nil {
+				return getErr
+			}
+			object = latest
+		}
+		return err
+	})
+	if apierrors.IsNotFound(err) {
+		// Deleted objects don't need to be migrated.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var bufferResource = schema.GroupVersionResource{Group: "autoscaling.x-k8s.io", Version: "v1beta1", Resource: "capacitybuffers"}
+
+func newCRD(t *testing.T, storedVersions ...string) *unstructured.Unstructured {
+	return newCRDWithConversion(t, &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.WebhookConverter}, storedVersions...)
+}
+
+func newCRDWithConversion(t *testing.T, conversion *apiextensionsv1.CustomResourceConversion, storedVersions ...string) *unstructured.Unstructured {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: "capacitybuffers.autoscaling.x-k8s.io"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "autoscaling.x-k8s.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: "capacitybuffers", Kind: "CapacityBuffer"},
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true},
+				{Name: "v1beta1", Served: true, Storage: true},
+			},
+			Conversion: conversion,
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+	}
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(crd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &unstructured.Unstructured{Object: object}
+}
+
+func newBuffer(namespace, name string) *unstructured.Unstructured {
+	buffer := &unstructured.Unstructured{}
+	buffer.SetAPIVersion(bufferResource.GroupVersion().String())
+	buffer.SetKind("CapacityBuffer")
+	buffer.SetNamespace(namespace)
+	buffer.SetName(name)
+	return buffer
+}
+
+func newFakeClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		crdResource:    "CustomResourceDefinitionList",
+		bufferResource: "CapacityBufferList",
+	}, objects...)
+}
+
+func TestMigrate(t *testing.T) {
+	client := newFakeClient(newCRD(t, "v1alpha1", "v1beta1"), newBuffer("default", "a"), newBuffer("default", "b"), newBuffer("other", "c"))
+	m := &migrator{client: client, pageSize: 2}
+	if err := m.migrate(context.Background(), "capacitybuffers.autoscaling.x-k8s.io"); err != nil {
+		t.Fatal(err)
+	}
+
+	var updated []string
+	for _, action := range client.Actions() {
+		if update, ok := action.(clienttesting.UpdateAction); ok && action.GetResource() == bufferResource {
+			object := update.GetObject().(*unstructured.Unstructured)
+			updated = append(updated, object.GetNamespace()+"/"+object.GetName())
+		}
+	}
+	if want := []string{"default/a", "default/b", "other/c"}; !reflect.DeepEqual(want, updated) {
+		t.Errorf("unexpected updated buffers %v, want %v", updated, want)
+	}
+
+	crd, err := m.getCRD(context.Background(), "capacitybuffers.autoscaling.x-k8s.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"v1beta1"}; !reflect.DeepEqual(want, crd.Status.StoredVersions) {
+		t.Errorf("unexpected stored versions %v, want %v", crd.Status.StoredVersions, want)
+	}
+}
+
+func TestMigrateAlreadyMigrated(t *testing.T) {
+	client := newFakeClient(newCRD(t, "v1beta1"), newBuffer("default", "a"))
+	m := &migrator{client: client, pageSize: 2}
+	if err := m.migrate(context.Background(), "capacitybuffers.autoscaling.x-k8s.io"); err != nil {
+		t.Fatal(err)
+	}
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" {
+			t.Errorf("unexpected update of %s", action.GetResource())
+		}
+	}
+}
+
+func TestMigrateWithoutConversionWebhook(t *testing.T) {
+	for _, tc := range []struct {
+		name                string
+		conversion          *apiextensionsv1.CustomResourceConversion
+		allowNoneConversion bool
+		wantErr             bool
+	}{
+		{
+			name:    "no conversion",
+			wantErr: true,
+		},
+		{
+			name:       "none conversion",
+			conversion: &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter},
+			wantErr:    true,
+		},
+		{
+			name:                "none conversion allowed",
+			conversion:          &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter},
+			allowNoneConversion: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newFakeClient(newCRDWithConversion(t, tc.conversion, "v1alpha1", "v1beta1"), newBuffer("default", "a"))
+			m := &migrator{client: client, pageSize: 2, allowNoneConversion: tc.allowNoneConversion}
+			err := m.migrate(context.Background(), "capacitybuffers.autoscaling.x-k8s.io")
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("unexpected error %v, want error: %v", err, tc.wantErr)
+			}
+			if !tc.wantErr {
+				return
+			}
+			for _, action := range client.Actions() {
+				if action.GetVerb() == "update" {
+					t.Errorf("unexpected update of %s", action.GetResource())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion serves CustomResourceDefinition conversion webhooks for
+// the APIs of this module. Objects are converted with the conversion functions
+// registered in a scheme, e.g. for Provisioning Requests:
+//
+//	scheme := runtime.NewScheme()
+//	_ = v1.AddToScheme(scheme)
+//	_ = v1beta1.AddToScheme(scheme)
+//	server := &conversion.Server{Addr: ":9443", CertFile: "tls.crt", KeyFile: "tls.key"}
+//	err := server.Run(ctx, conversion.NewWebhook(scheme))
+//
+// The CustomResourceDefinition has to use the webhook conversion strategy
+// pointing at the server's Service:
+//
+//	conversion:
+//	  strategy: Webhook
+//	  webhook:
+//	    conversionReviewVersions: ["v1"]
+//	    clientConfig:
+//	      service:
+//	        name: <service>
+//	        namespace: <namespace>
+//	        path: /convert
+package conversion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+const (
+	// DefaultPath is the path the webhook is served at if Server.Path is empty.
+	DefaultPath = "/convert"
+	// maxRequestSize limits the size of conversion reviews, the API server
+	// sends at most 3MiB of objects.
+	maxRequestSize = 5 * 1024 * 1024
+)
+
+// Webhook is an http.Handler serving ConversionReviews. Objects are converted
+// between the versions registered in its scheme.
+type Webhook struct {
+	scheme  *runtime.Scheme
+	decoder runtime.Decoder
+}
+
+// NewWebhook creates a Webhook converting objects with the conversion
+// functions registered in the scheme.
+func NewWebhook(scheme *runtime.Scheme) *Webhook {
+	return &Webhook{
+		scheme:  scheme,
+		decoder: serializer.NewCodecFactory(scheme).UniversalDeserializer(),
+	}
+}
+
+// ServeHTTP handles a ConversionReview request.
+func (w *Webhook) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxRequestSize))
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+		return
+	}
+	review := &apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(rw, fmt.Sprintf("failed to decode ConversionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+	review.Response = w.Convert(review.Request)
+	review.Request = nil
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(review); err != nil {
+		http.Error(rw, fmt.Sprintf("failed to encode ConversionReview: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// Convert converts the objects of a ConversionRequest to its desired version.
+// The conversion fails as a whole if any of the objects can't be converted.
+func (w *Webhook) Convert(request *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	response := &apiextensionsv1.ConversionResponse{UID: request.UID}
+	desired, err := schema.ParseGroupVersion(request.DesiredAPIVersion)
+	if err != nil {
+		response.Result = failure(fmt.Errorf("invalid desired API version: %v", err))
+		return response
+	}
+	for i, object := range request.Objects {
+		converted, err := w.convertObject(object.Raw, desired)
+		if err != nil {
+			response.ConvertedObjects = nil
+			response.Result = failure(fmt.Errorf("failed to convert object %d: %v", i, err))
+			return response
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+	response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return response
+}
+
+func (w *Webhook) convertObject(raw []byte, desired schema.GroupVersion) ([]byte, error) {
+	in, gvk, err := w.decoder.Decode(raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if gvk.GroupVersion() == desired {
+		return raw, nil
+	}
+	out, err := w.scheme.New(desired.WithKind(gvk.Kind))
+	if err != nil {
+		return nil, err
+	}
+	if err := w.scheme.Convert(in, out, nil); err != nil {
+		return nil, err
+	}
+	out.GetObjectKind().SetGroupVersionKind(desired.WithKind(gvk.Kind))
+	return json.Marshal(out)
+}
+
+func failure(err error) metav1.Status {
+	return metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+}
+
+// Server serves a Webhook over HTTPS.
+type Server struct {
+	// Addr is the address the server listens on, e.g. ":9443".
+	Addr string
+	// CertFile and KeyFile are the paths of the serving certificate and its key.
+	CertFile string
+	KeyFile  string
+	// Path is the path the webhook is served at, DefaultPath if empty.
+	Path string
+}
+
+// Run serves the webhook until the context is done. The server also answers
+// health checks at /healthz.
+func (s *Server) Run(ctx context.Context, webhook *Webhook) error {
+	path := s.Path
+	if path == "" {
+		path = DefaultPath
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, webhook)
+	mux.HandleFunc("/healthz", func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	})
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.ListenAndServeTLS(s.CertFile, s.KeyFile)
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
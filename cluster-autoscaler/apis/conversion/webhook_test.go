@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	v1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	"k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1beta1"
+)
+
+func newTestWebhook(t *testing.T) *Webhook {
+	scheme := runtime.NewScheme()
+	if err := v1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return NewWebhook(scheme)
+}
+
+func review(t *testing.T, webhook *Webhook, desiredAPIVersion string, objects ...runtime.Object) *apiextensionsv1.ConversionResponse {
+	request := &apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request:  &apiextensionsv1.ConversionRequest{UID: "uid", DesiredAPIVersion: desiredAPIVersion},
+	}
+	for _, object := range objects {
+		raw, err := json.Marshal(object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Request.Objects = append(request.Request.Objects, runtime.RawExtension{Raw: raw})
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := httptest.NewRecorder()
+	webhook.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, DefaultPath, bytes.NewReader(body)))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", recorder.Code, recorder.Body.String())
+	}
+	response := &apiextensionsv1.ConversionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Response == nil || response.Response.UID != "uid" {
+		t.Fatalf("unexpected response: %+v", response.Response)
+	}
+	return response.Response
+}
+
+func TestWebhookConvert(t *testing.T) {
+	webhook := newTestWebhook(t)
+	notBefore := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	hub := &v1.ProvisioningRequest{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: "ProvisioningRequest"},
+		ObjectMeta: metav1.ObjectMeta{Name: "req", Namespace: "default"},
+		Spec: v1.ProvisioningRequestSpec{
+			PodSets:               []v1.PodSet{{PodTemplateRef: v1.Reference{Name: "template"}, Count: 3}},
+			ProvisioningClassName: v1.ProvisioningClassCheckCapacity,
+			NotBefore:             &notBefore,
+		},
+	}
+
+	// v1 to v1beta1.
+	response := review(t, webhook, v1beta1.SchemeGroupVersion.String(), hub)
+	if response.Result.Status != metav1.StatusSuccess || len(response.ConvertedObjects) != 1 {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+	spoke := &v1beta1.ProvisioningRequest{}
+	if err := json.Unmarshal(response.ConvertedObjects[0].Raw, spoke); err != nil {
+		t.Fatal(err)
+	}
+	if spoke.APIVersion != v1beta1.SchemeGroupVersion.String() || spoke.Kind != "ProvisioningRequest" {
+		t.Errorf("unexpected type of converted object: %v", spoke.TypeMeta)
+	}
+	if spoke.Name != "req" || spoke.Spec.PodSets[0].Count != 3 || spoke.Annotations[v1beta1.ConversionDataAnnotationKey] == "" {
+		t.Errorf("unexpected converted object: %+v", spoke)
+	}
+
+	// And back to v1.
+	response = review(t, webhook, v1.SchemeGroupVersion.String(), spoke)
+	if response.Result.Status != metav1.StatusSuccess || len(response.ConvertedObjects) != 1 {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+	converted := &v1.ProvisioningRequest{}
+	if err := json.Unmarshal(response.ConvertedObjects[0].Raw, converted); err != nil {
+		t.Fatal(err)
+	}
+	if converted.Spec.NotBefore == nil || !converted.Spec.NotBefore.Equal(&notBefore) || len(converted.Annotations) != 0 {
+		t.Errorf("unexpected converted object: %+v", converted)
+	}
+}
+
+func TestWebhookConvertFailure(t *testing.T) {
+	webhook := newTestWebhook(t)
+	unknown := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "example.com/v1", Kind: "Unknown"}}
+	response := review(t, webhook, v1.SchemeGroupVersion.String(), unknown)
+	if response.Result.Status != metav1.StatusFailure || len(response.ConvertedObjects) != 0 {
+		t.Errorf("unexpected response: %+v", response)
+	}
+
+	recorder := httptest.NewRecorder()
+	webhook.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, DefaultPath, bytes.NewReader([]byte("{"))))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("unexpected status code %d for malformed review", recorder.Code)
+	}
+}
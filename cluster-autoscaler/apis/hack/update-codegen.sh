@@ -45,5 +45,12 @@ do
     "${REPO_ROOT}/cluster-autoscaler/apis/$crd"
 done
 
+# Older API versions are converted to and from the storage version.
+"${GO_CMD}" run k8s.io/code-generator/cmd/conversion-gen \
+  --output-file zz_generated.conversion.go \
+  --go-header-file "${REPO_ROOT}/hack/boilerplate/boilerplate.generatego.txt" \
+  ./provisioningrequest/autoscaling.x-k8s.io/v1beta1 \
+  ./capacitybuffer/autoscaling.x-k8s.io/v1alpha1
+
 # We need to clean up the go.mod file since code-generator adds temporary library to the go.mod file.
 "${GO_CMD}" mod tidy
@@ -15,6 +15,8 @@ limitations under the License.
 */
 
 // Package v1 contains definitions of Provisioning Request related objects.
+// It's the storage and hub version of the API, the other versions are converted
+// to and from it.
 // +k8s:deepcopy-gen=package
 // +k8s:defaulter-gen=TypeMeta
 // +groupName=autoscaling.x-k8s.io
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:resource:scope=Cluster,shortName=provclass;provclasses
+
+// ProvisioningClass describes a mode of provisioning resources for ProvisioningRequests.
+// ProvisioningRequests refer to it by name in their 'provisioningClassName' field.
+// It declares the parameters the requests of the class accept and the controller
+// responsible for the requests. The API server only validates the class itself:
+// checking and defaulting the parameters of ProvisioningRequests against it is
+// left to the controller of the class, and cluster autoscaler doesn't do it yet.
+//
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Controller",type="string",JSONPath=".spec.controllerName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ProvisioningClass struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
+	//
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec contains specification of the ProvisioningClass object.
+	//
+	// +kubebuilder:validation:Required
+	Spec ProvisioningClassSpec `json:"spec"`
+}
+
+// ProvisioningClassList is a object for list of ProvisioningClass.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ProvisioningClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	//
+	// +optional
+	metav1.ListMeta `json:"metadata"`
+	// Items, list of ProvisioningClass returned from API.
+	//
+	// +optional
+	Items []ProvisioningClass `json:"items"`
+}
+
+// ProvisioningClassSpec is a specification of a provisioning class.
+type ProvisioningClassSpec struct {
+	// ControllerName is the name of the controller that handles ProvisioningRequests
+	// of this class, e.g. 'cluster-autoscaler.kubernetes.io'. Controllers ignore
+	// requests of classes with a different controller name.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*(/[-a-zA-Z0-9_.]+)?$`
+	// +kubebuilder:validation:MaxLength=253
+	ControllerName string `json:"controllerName"`
+
+	// Parameters lists the parameters ProvisioningRequests of this class accept.
+	// The controller of the class is expected to reject requests setting parameters
+	// that aren't listed; the API server doesn't check requests against the class.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=100
+	Parameters []ParameterSchema `json:"parameters,omitempty"`
+
+	// AllowedNamespaces lists the namespaces ProvisioningRequests of this class
+	// may be created in. Requests of the class are allowed in all namespaces if empty.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=256
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:items:MaxLength=63
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// ParameterSchema describes a parameter of ProvisioningRequests of a class.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.required) && self.required && has(self.default))",message="required parameters can't have a default"
+// +kubebuilder:validation:XValidation:rule="!has(self.enum) || !has(self.default) || self.default in self.enum",message="default must be one of enum"
+// +kubebuilder:validation:XValidation:rule="!has(self.default) || (self.type == 'Integer' ? self.default.matches('^[-+]?[0-9]+$') : self.type == 'Boolean' ? self.default in ['true', 'false'] : self.type != 'Duration' || self.default.matches('^[-+]?(0|(([0-9]+[.]?[0-9]*|[.][0-9]+)([nuµμm]?s|m|h))+)$'))",message="default must be a value of the parameter type"
+// +kubebuilder:validation:XValidation:rule="!has(self.enum) || (self.type == 'Integer' ? self.enum.all(v, v.matches('^[-+]?[0-9]+$')) : self.type == 'Boolean' ? self.enum.all(v, v in ['true', 'false']) : self.type != 'Duration' || self.enum.all(v, v.matches('^[-+]?(0|(([0-9]+[.]?[0-9]*|[.][0-9]+)([nuµμm]?s|m|h))+)$')))",message="enum values must be values of the parameter type"
+type ParameterSchema struct {
+	// Name of the parameter, the key in the 'parameters' of ProvisioningRequests.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_.-]*$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// Description of the parameter for users of the class.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Description string `json:"description,omitempty"`
+
+	// Type of the parameter values.
+	//
+	// +kubebuilder:validation:Required
+	Type ParameterType `json:"type"`
+
+	// Required parameters must be set by every ProvisioningRequest of the class.
+	//
+	// +optional
+	Required bool `json:"required,omitempty"`
+
+	// Default is the value of the parameter for ProvisioningRequests that don't set it.
+	//
+	// +optional
+	Default *Parameter `json:"default,omitempty"`
+
+	// Enum lists the allowed values of the parameter. All values of the type
+	// are allowed if empty.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	Enum []Parameter `json:"enum,omitempty"`
+}
+
+// ParameterType is the type of the values of a parameter.
+// +kubebuilder:validation:Enum=String;Integer;Boolean;Duration
+type ParameterType string
+
+const (
+	// ParameterTypeString accepts any string.
+	ParameterTypeString ParameterType = "String"
+	// ParameterTypeInteger accepts base 10 integers, e.g. '42'.
+	ParameterTypeInteger ParameterType = "Integer"
+	// ParameterTypeBoolean accepts 'true' and 'false'.
+	ParameterTypeBoolean ParameterType = "Boolean"
+	// ParameterTypeDuration accepts durations in the format of Go's time.ParseDuration, e.g. '1h30m'.
+	ParameterTypeDuration ParameterType = "Duration"
+)
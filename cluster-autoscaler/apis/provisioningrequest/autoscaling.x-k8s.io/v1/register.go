@@ -49,6 +49,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ProvisioningRequest{},
 		&ProvisioningRequestList{},
+		&ProvisioningClass{},
+		&ProvisioningClassList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
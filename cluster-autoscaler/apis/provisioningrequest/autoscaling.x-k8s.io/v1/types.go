@@ -18,6 +18,8 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	// Dependencies for the generation of the code:
@@ -74,6 +76,8 @@ type ProvisioningRequestList struct {
 
 // ProvisioningRequestSpec is a specification of additional pods for which we
 // would like to provision additional resources in the cluster.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.notBefore) || !has(self.deadline) || self.deadline > self.notBefore",message="deadline must be after notBefore"
 type ProvisioningRequestSpec struct {
 	// PodSets lists groups of pods for which we would like to provision
 	// resources.
@@ -85,8 +89,8 @@ type ProvisioningRequestSpec struct {
 	PodSets []PodSet `json:"podSets"`
 
 	// ProvisioningClassName describes the different modes of provisioning the resources.
-	// Currently there is no support for 'ProvisioningClass' objects.
-	// Supported values:
+	// It may be the name of a 'ProvisioningClass' object, which declares the parameters
+	// requests of the class accept. Built-in values that don't need a 'ProvisioningClass':
 	// * check-capacity.autoscaling.x-k8s.io - check if current cluster state can fullfil this request,
 	//   do not reserve the capacity. Users should provide a reference to a valid PodTemplate object.
 	//   CA will check if there is enough capacity in cluster to fulfill the request and put
@@ -114,6 +118,47 @@ type ProvisioningRequestSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// +kubebuilder:validation:MaxProperties=100
 	Parameters map[string]Parameter `json:"parameters"`
+
+	// NotBefore is the earliest time the capacity is needed. The resources won't be
+	// provisioned and the booking won't start before it. Requests without it are
+	// provisioned as soon as possible.
+	//
+	// The field is API-only for now: Cluster Autoscaler validates it, but provisions
+	// requests as soon as possible regardless of it.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// Deadline is the time by which the capacity has to be provisioned. If it isn't
+	// provisioned by then, the request gets 'Failed=true' condition and no more
+	// attempts are made. Requests without it are retried for as long as their
+	// provisioning class allows, e.g. 'ValidUntilSeconds' parameter.
+	//
+	// The field is API-only for now: Cluster Autoscaler validates it, but doesn't
+	// fail requests once it has passed.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	Deadline *metav1.Time `json:"deadline,omitempty"`
+
+	// BookingDuration is how long the capacity stays booked for the pods of the
+	// request once it's provisioned, or from NotBefore if it was provisioned earlier.
+	// Afterwards the request gets 'BookingExpired=true' condition and the capacity
+	// may be scaled down.
+	//
+	// Defaults to 10m. The API server applies the default to every v1 object that
+	// omits the field, so requests created before the field existed are returned
+	// with bookingDuration set to 10m too.
+	//
+	// The field is API-only for now: Cluster Autoscaler keeps the capacity booked
+	// for a fixed 10 minutes regardless of it.
+	//
+	// +optional
+	// +kubebuilder:default="10m"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="bookingDuration must be positive"
+	BookingDuration *metav1.Duration `json:"bookingDuration,omitempty"`
 }
 
 // Parameter is limited to 255 characters.
@@ -167,6 +212,21 @@ type ProvisioningRequestStatus struct {
 	// +optional
 	// +kubebuilder:validation:MaxProperties=64
 	ProvisioningClassDetails map[string]Detail `json:"provisioningClassDetails"`
+
+	// BookingStartTime is the time the booking of the provisioned capacity started.
+	//
+	// The field is API-only for now: Cluster Autoscaler doesn't set it.
+	//
+	// +optional
+	BookingStartTime *metav1.Time `json:"bookingStartTime,omitempty"`
+
+	// BookingExpiryTime is the time the booking of the provisioned capacity expires,
+	// BookingStartTime plus the request's BookingDuration.
+	//
+	// The field is API-only for now: Cluster Autoscaler doesn't set it.
+	//
+	// +optional
+	BookingExpiryTime *metav1.Time `json:"bookingExpiryTime,omitempty"`
 }
 
 // Detail is limited to 32768 characters.
@@ -206,3 +266,6 @@ const (
 	// ProvisioningClassPodAnnotationKey is a key used to add annotation about Provisioning Class
 	ProvisioningClassPodAnnotationKey = "autoscaling.x-k8s.io/provisioning-class-name"
 )
+
+// DefaultBookingDuration is the BookingDuration of requests that don't set it.
+const DefaultBookingDuration = 10 * time.Minute
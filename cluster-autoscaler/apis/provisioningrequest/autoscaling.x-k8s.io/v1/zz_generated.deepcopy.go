@@ -25,6 +25,31 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSchema) DeepCopyInto(out *ParameterSchema) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(Parameter)
+		**out = **in
+	}
+	if in.Enum != nil {
+		in, out := &in.Enum, &out.Enum
+		*out = make([]Parameter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSchema.
+func (in *ParameterSchema) DeepCopy() *ParameterSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSet) DeepCopyInto(out *PodSet) {
 	*out = *in
@@ -41,6 +66,91 @@ func (in *PodSet) DeepCopy() *PodSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningClass) DeepCopyInto(out *ProvisioningClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningClass.
+func (in *ProvisioningClass) DeepCopy() *ProvisioningClass {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisioningClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningClassList) DeepCopyInto(out *ProvisioningClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProvisioningClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningClassList.
+func (in *ProvisioningClassList) DeepCopy() *ProvisioningClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisioningClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningClassSpec) DeepCopyInto(out *ProvisioningClassSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ParameterSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningClassSpec.
+func (in *ProvisioningClassSpec) DeepCopy() *ProvisioningClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisioningRequest) DeepCopyInto(out *ProvisioningRequest) {
 	*out = *in
@@ -115,6 +225,19 @@ func (in *ProvisioningRequestSpec) DeepCopyInto(out *ProvisioningRequestSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.Deadline != nil {
+		in, out := &in.Deadline, &out.Deadline
+		*out = (*in).DeepCopy()
+	}
+	if in.BookingDuration != nil {
+		in, out := &in.BookingDuration, &out.BookingDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestSpec.
@@ -144,6 +267,14 @@ func (in *ProvisioningRequestStatus) DeepCopyInto(out *ProvisioningRequestStatus
 			(*out)[key] = val
 		}
 	}
+	if in.BookingStartTime != nil {
+		in, out := &in.BookingStartTime, &out.BookingStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BookingExpiryTime != nil {
+		in, out := &in.BookingExpiryTime, &out.BookingExpiryTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestStatus.
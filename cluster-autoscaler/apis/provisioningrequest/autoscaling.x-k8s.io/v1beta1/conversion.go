@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	v1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+)
+
+// ConversionDataAnnotationKey is the annotation holding the fields of a v1
+// ProvisioningRequest that v1beta1 doesn't have, so that converting a request
+// to v1beta1 and back to v1 is lossless.
+const ConversionDataAnnotationKey = "autoscaling.x-k8s.io/conversion-data"
+
+// conversionData holds the fields of v1 ProvisioningRequests without a v1beta1 counterpart.
+// +k8s:deepcopy-gen=false
+type conversionData struct {
+	NotBefore         *metav1.Time     `json:"notBefore,omitempty"`
+	Deadline          *metav1.Time     `json:"deadline,omitempty"`
+	BookingDuration   *metav1.Duration `json:"bookingDuration,omitempty"`
+	BookingStartTime  *metav1.Time     `json:"bookingStartTime,omitempty"`
+	BookingExpiryTime *metav1.Time     `json:"bookingExpiryTime,omitempty"`
+}
+
+// Convert_v1_ProvisioningRequest_To_v1beta1_ProvisioningRequest converts a v1 ProvisioningRequest
+// to v1beta1, keeping the fields v1beta1 doesn't have in the conversion data annotation.
+func Convert_v1_ProvisioningRequest_To_v1beta1_ProvisioningRequest(in *v1.ProvisioningRequest, out *ProvisioningRequest, s conversion.Scope) error {
+	if err := autoConvert_v1_ProvisioningRequest_To_v1beta1_ProvisioningRequest(in, out, s); err != nil {
+		return err
+	}
+	data := conversionData{
+		NotBefore:         in.Spec.NotBefore,
+		Deadline:          in.Spec.Deadline,
+		BookingDuration:   in.Spec.BookingDuration,
+		BookingStartTime:  in.Status.BookingStartTime,
+		BookingExpiryTime: in.Status.BookingExpiryTime,
+	}
+	if data == (conversionData{}) {
+		return nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s annotation: %v", ConversionDataAnnotationKey, err)
+	}
+	// The generated conversion shares the annotations with the input.
+	annotations := make(map[string]string, len(in.Annotations)+1)
+	for key, value := range in.Annotations {
+		annotations[key] = value
+	}
+	annotations[ConversionDataAnnotationKey] = string(raw)
+	out.Annotations = annotations
+	return nil
+}
+
+// Convert_v1beta1_ProvisioningRequest_To_v1_ProvisioningRequest converts a v1beta1 ProvisioningRequest
+// to v1, restoring the fields kept in the conversion data annotation. Annotations that can't
+// be parsed are left in place.
+func Convert_v1beta1_ProvisioningRequest_To_v1_ProvisioningRequest(in *ProvisioningRequest, out *v1.ProvisioningRequest, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_ProvisioningRequest_To_v1_ProvisioningRequest(in, out, s); err != nil {
+		return err
+	}
+	raw, found := in.Annotations[ConversionDataAnnotationKey]
+	if !found {
+		return nil
+	}
+	var data conversionData
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return nil
+	}
+	out.Spec.NotBefore = data.NotBefore
+	out.Spec.Deadline = data.Deadline
+	out.Spec.BookingDuration = data.BookingDuration
+	out.Status.BookingStartTime = data.BookingStartTime
+	out.Status.BookingExpiryTime = data.BookingExpiryTime
+
+	var annotations map[string]string
+	for key, value := range in.Annotations {
+		if key == ConversionDataAnnotationKey {
+			continue
+		}
+		if annotations == nil {
+			annotations = make(map[string]string, len(in.Annotations)-1)
+		}
+		annotations[key] = value
+	}
+	out.Annotations = annotations
+	return nil
+}
+
+// Convert_v1_ProvisioningRequestSpec_To_v1beta1_ProvisioningRequestSpec converts a v1 ProvisioningRequestSpec
+// to v1beta1. The scheduling window fields are kept by the ProvisioningRequest conversion.
+func Convert_v1_ProvisioningRequestSpec_To_v1beta1_ProvisioningRequestSpec(in *v1.ProvisioningRequestSpec, out *ProvisioningRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_ProvisioningRequestSpec_To_v1beta1_ProvisioningRequestSpec(in, out, s)
+}
+
+// Convert_v1_ProvisioningRequestStatus_To_v1beta1_ProvisioningRequestStatus converts a v1 ProvisioningRequestStatus
+// to v1beta1. The booking times are kept by the ProvisioningRequest conversion.
+func Convert_v1_ProvisioningRequestStatus_To_v1beta1_ProvisioningRequestStatus(in *v1.ProvisioningRequestStatus, out *ProvisioningRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_ProvisioningRequestStatus_To_v1beta1_ProvisioningRequestStatus(in, out, s)
+}
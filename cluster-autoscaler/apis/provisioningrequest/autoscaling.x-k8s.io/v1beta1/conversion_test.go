@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	v1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	"sigs.k8s.io/randfill"
+)
+
+const fuzzIterations = 1000
+
+func newScheme(t testing.TB) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := v1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+// fuzzerFuncs fill optional timestamps. randfill leaves them nil otherwise,
+// as metav1.Time fills itself.
+func fuzzerFuncs(_ serializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(t **metav1.Time, c randfill.Continue) {
+			if c.Bool() {
+				*t = nil
+				return
+			}
+			*t = &metav1.Time{}
+			c.Fill(*t)
+		},
+	}
+}
+
+// roundTrip fills a hub object with random values, converts it to the spoke
+// version and back, and checks nothing was lost. The same is checked for
+// spoke objects converted to the hub version and back.
+func roundTrip(t *testing.T, scheme *runtime.Scheme, seed int64) {
+	filler := fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, fuzzerFuncs), rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+	for _, pair := range []struct {
+		original, intermediate, result runtime.Object
+	}{
+		{&v1.ProvisioningRequest{}, &ProvisioningRequest{}, &v1.ProvisioningRequest{}},
+		{&ProvisioningRequest{}, &v1.ProvisioningRequest{}, &ProvisioningRequest{}},
+		{&v1.ProvisioningRequestList{}, &ProvisioningRequestList{}, &v1.ProvisioningRequestList{}},
+		{&ProvisioningRequestList{}, &v1.ProvisioningRequestList{}, &ProvisioningRequestList{}},
+	} {
+		filler.Fill(pair.original)
+		original := pair.original.DeepCopyObject()
+		if err := scheme.Convert(pair.original, pair.intermediate, nil); err != nil {
+			t.Fatalf("seed %d: failed to convert %T to %T: %v", seed, pair.original, pair.intermediate, err)
+		}
+		if err := scheme.Convert(pair.intermediate, pair.result, nil); err != nil {
+			t.Fatalf("seed %d: failed to convert %T to %T: %v", seed, pair.intermediate, pair.result, err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, pair.original) {
+			t.Fatalf("seed %d: conversion modified the input %T: %s", seed, original, diff.Diff(original, pair.original))
+		}
+		if !apiequality.Semantic.DeepEqual(original, pair.result) {
+			t.Fatalf("seed %d: %T changed in round trip: %s", seed, original, diff.Diff(original, pair.result))
+		}
+	}
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+	seed := time.Now().UnixNano()
+	for i := int64(0); i < fuzzIterations; i++ {
+		roundTrip(t, scheme, seed+i)
+	}
+}
+
+func FuzzConversionRoundTrip(f *testing.F) {
+	scheme := newScheme(f)
+	for seed := int64(0); seed < 10; seed++ {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		roundTrip(t, scheme, seed)
+	})
+}
+
+func TestConversionDataAnnotation(t *testing.T) {
+	scheme := newScheme(t)
+	notBefore := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	hub := &v1.ProvisioningRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "req", Annotations: map[string]string{"foo": "bar"}},
+		Spec: v1.ProvisioningRequestSpec{
+			ProvisioningClassName: v1.ProvisioningClassCheckCapacity,
+			NotBefore:             &notBefore,
+			BookingDuration:       &metav1.Duration{Duration: time.Hour},
+		},
+	}
+
+	spoke := &ProvisioningRequest{}
+	if err := scheme.Convert(hub, spoke, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"foo":                       "bar",
+		ConversionDataAnnotationKey: `{"notBefore":"2026-01-02T03:04:05Z","bookingDuration":"1h0m0s"}`,
+	}
+	if !apiequality.Semantic.DeepEqual(want, spoke.Annotations) {
+		t.Errorf("unexpected annotations: %s", diff.Diff(want, spoke.Annotations))
+	}
+	if _, found := hub.Annotations[ConversionDataAnnotationKey]; found {
+		t.Errorf("conversion modified the annotations of the input")
+	}
+
+	// Requests that don't set the v1 fields aren't annotated.
+	hub.Spec.NotBefore, hub.Spec.BookingDuration = nil, nil
+	spoke = &ProvisioningRequest{}
+	if err := scheme.Convert(hub, spoke, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := spoke.Annotations[ConversionDataAnnotationKey]; found {
+		t.Errorf("unexpected %s annotation", ConversionDataAnnotationKey)
+	}
+
+	// Annotations that can't be parsed are kept.
+	spoke.Annotations = map[string]string{ConversionDataAnnotationKey: "{"}
+	converted := &v1.ProvisioningRequest{}
+	if err := scheme.Convert(spoke, converted, nil); err != nil {
+		t.Fatal(err)
+	}
+	if converted.Annotations[ConversionDataAnnotationKey] != "{" {
+		t.Errorf("malformed %s annotation wasn't kept", ConversionDataAnnotationKey)
+	}
+}
@@ -17,5 +17,6 @@ limitations under the License.
 // Package v1beta1 contains definitions of Provisioning Request related objects.
 // +k8s:deepcopy-gen=package
 // +k8s:defaulter-gen=TypeMeta
+// +k8s:conversion-gen=k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1
 // +groupName=autoscaling.x-k8s.io
 package v1beta1
@@ -36,8 +36,10 @@ var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: GroupVer
 var (
 	// SchemeBuilder is the scheme builder for ProvisioningRequest.
 	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	// localSchemeBuilder is used by the generated conversion functions to register themselves.
+	localSchemeBuilder = &SchemeBuilder
 	// AddToScheme is the func that applies all the stored functions to the scheme.
-	AddToScheme = SchemeBuilder.AddToScheme
+	AddToScheme = localSchemeBuilder.AddToScheme
 )
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
@@ -19,6 +19,7 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	autoscalingxk8siov1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
 )
 
@@ -32,8 +33,8 @@ type ProvisioningRequestSpecApplyConfiguration struct {
 	// resources.
 	PodSets []PodSetApplyConfiguration `json:"podSets,omitempty"`
 	// ProvisioningClassName describes the different modes of provisioning the resources.
-	// Currently there is no support for 'ProvisioningClass' objects.
-	// Supported values:
+	// It may be the name of a 'ProvisioningClass' object, which declares the parameters
+	// requests of the class accept. Built-in values that don't need a 'ProvisioningClass':
 	// * check-capacity.autoscaling.x-k8s.io - check if current cluster state can fullfil this request,
 	// do not reserve the capacity. Users should provide a reference to a valid PodTemplate object.
 	// CA will check if there is enough capacity in cluster to fulfill the request and put
@@ -51,6 +52,33 @@ type ProvisioningRequestSpecApplyConfiguration struct {
 	// 'best-effort-atomic-scale-up.autoscaling.x-k8s.io' supports 'ValidUntilSeconds' parameter, which should contain
 	// a string denoting duration for which we should retry (measured since creation fo the CR).
 	Parameters map[string]autoscalingxk8siov1.Parameter `json:"parameters,omitempty"`
+	// NotBefore is the earliest time the capacity is needed. The resources won't be
+	// provisioned and the booking won't start before it. Requests without it are
+	// provisioned as soon as possible.
+	//
+	// The field is API-only for now: Cluster Autoscaler validates it, but provisions
+	// requests as soon as possible regardless of it.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// Deadline is the time by which the capacity has to be provisioned. If it isn't
+	// provisioned by then, the request gets 'Failed=true' condition and no more
+	// attempts are made. Requests without it are retried for as long as their
+	// provisioning class allows, e.g. 'ValidUntilSeconds' parameter.
+	//
+	// The field is API-only for now: Cluster Autoscaler validates it, but doesn't
+	// fail requests once it has passed.
+	Deadline *metav1.Time `json:"deadline,omitempty"`
+	// BookingDuration is how long the capacity stays booked for the pods of the
+	// request once it's provisioned, or from NotBefore if it was provisioned earlier.
+	// Afterwards the request gets 'BookingExpired=true' condition and the capacity
+	// may be scaled down.
+	//
+	// Defaults to 10m. The API server applies the default to every v1 object that
+	// omits the field, so requests created before the field existed are returned
+	// with bookingDuration set to 10m too.
+	//
+	// The field is API-only for now: Cluster Autoscaler keeps the capacity booked
+	// for a fixed 10 minutes regardless of it.
+	BookingDuration *metav1.Duration `json:"bookingDuration,omitempty"`
 }
 
 // ProvisioningRequestSpecApplyConfiguration constructs a declarative configuration of the ProvisioningRequestSpec type for use with
@@ -93,3 +121,27 @@ func (b *ProvisioningRequestSpecApplyConfiguration) WithParameters(entries map[s
 	}
 	return b
 }
+
+// WithNotBefore sets the NotBefore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NotBefore field is set to the value of the last call.
+func (b *ProvisioningRequestSpecApplyConfiguration) WithNotBefore(value metav1.Time) *ProvisioningRequestSpecApplyConfiguration {
+	b.NotBefore = &value
+	return b
+}
+
+// WithDeadline sets the Deadline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Deadline field is set to the value of the last call.
+func (b *ProvisioningRequestSpecApplyConfiguration) WithDeadline(value metav1.Time) *ProvisioningRequestSpecApplyConfiguration {
+	b.Deadline = &value
+	return b
+}
+
+// WithBookingDuration sets the BookingDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BookingDuration field is set to the value of the last call.
+func (b *ProvisioningRequestSpecApplyConfiguration) WithBookingDuration(value metav1.Duration) *ProvisioningRequestSpecApplyConfiguration {
+	b.BookingDuration = &value
+	return b
+}
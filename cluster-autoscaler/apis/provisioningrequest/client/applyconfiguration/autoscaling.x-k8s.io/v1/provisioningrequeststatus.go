@@ -19,6 +19,7 @@ limitations under the License.
 package v1
 
 import (
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	autoscalingxk8siov1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
@@ -36,6 +37,15 @@ type ProvisioningRequestStatusApplyConfiguration struct {
 	// ProvisioningClassDetails contains all other values custom provisioning classes may
 	// want to pass to end users.
 	ProvisioningClassDetails map[string]autoscalingxk8siov1.Detail `json:"provisioningClassDetails,omitempty"`
+	// BookingStartTime is the time the booking of the provisioned capacity started.
+	//
+	// The field is API-only for now: Cluster Autoscaler doesn't set it.
+	BookingStartTime *apismetav1.Time `json:"bookingStartTime,omitempty"`
+	// BookingExpiryTime is the time the booking of the provisioned capacity expires,
+	// BookingStartTime plus the request's BookingDuration.
+	//
+	// The field is API-only for now: Cluster Autoscaler doesn't set it.
+	BookingExpiryTime *apismetav1.Time `json:"bookingExpiryTime,omitempty"`
 }
 
 // ProvisioningRequestStatusApplyConfiguration constructs a declarative configuration of the ProvisioningRequestStatus type for use with
@@ -70,3 +80,19 @@ func (b *ProvisioningRequestStatusApplyConfiguration) WithProvisioningClassDetai
 	}
 	return b
 }
+
+// WithBookingStartTime sets the BookingStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BookingStartTime field is set to the value of the last call.
+func (b *ProvisioningRequestStatusApplyConfiguration) WithBookingStartTime(value apismetav1.Time) *ProvisioningRequestStatusApplyConfiguration {
+	b.BookingStartTime = &value
+	return b
+}
+
+// WithBookingExpiryTime sets the BookingExpiryTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BookingExpiryTime field is set to the value of the last call.
+func (b *ProvisioningRequestStatusApplyConfiguration) WithBookingExpiryTime(value apismetav1.Time) *ProvisioningRequestStatusApplyConfiguration {
+	b.BookingExpiryTime = &value
+	return b
+}
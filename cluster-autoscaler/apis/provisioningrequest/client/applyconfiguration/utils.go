@@ -34,8 +34,14 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=autoscaling.x-k8s.io, Version=v1
+	case v1.SchemeGroupVersion.WithKind("ParameterSchema"):
+		return &autoscalingxk8siov1.ParameterSchemaApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodSet"):
 		return &autoscalingxk8siov1.PodSetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProvisioningClass"):
+		return &autoscalingxk8siov1.ProvisioningClassApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProvisioningClassSpec"):
+		return &autoscalingxk8siov1.ProvisioningClassSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProvisioningRequest"):
 		return &autoscalingxk8siov1.ProvisioningRequestApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProvisioningRequestSpec"):
@@ -28,6 +28,7 @@ import (
 
 type AutoscalingV1Interface interface {
 	RESTClient() rest.Interface
+	ProvisioningClassesGetter
 	ProvisioningRequestsGetter
 }
 
@@ -36,6 +37,10 @@ type AutoscalingV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *AutoscalingV1Client) ProvisioningClasses() ProvisioningClassInterface {
+	return newProvisioningClasses(c)
+}
+
 func (c *AutoscalingV1Client) ProvisioningRequests(namespace string) ProvisioningRequestInterface {
 	return newProvisioningRequests(c, namespace)
 }
@@ -28,6 +28,10 @@ type FakeAutoscalingV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeAutoscalingV1) ProvisioningClasses() v1.ProvisioningClassInterface {
+	return newFakeProvisioningClasses(c)
+}
+
 func (c *FakeAutoscalingV1) ProvisioningRequests(namespace string) v1.ProvisioningRequestInterface {
 	return newFakeProvisioningRequests(c, namespace)
 }
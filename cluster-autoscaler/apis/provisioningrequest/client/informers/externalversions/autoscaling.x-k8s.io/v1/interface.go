@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ProvisioningClasses returns a ProvisioningClassInformer.
+	ProvisioningClasses() ProvisioningClassInformer
 	// ProvisioningRequests returns a ProvisioningRequestInformer.
 	ProvisioningRequests() ProvisioningRequestInformer
 }
@@ -39,6 +41,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ProvisioningClasses returns a ProvisioningClassInformer.
+func (v *version) ProvisioningClasses() ProvisioningClassInformer {
+	return &provisioningClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ProvisioningRequests returns a ProvisioningRequestInformer.
 func (v *version) ProvisioningRequests() ProvisioningRequestInformer {
 	return &provisioningRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -54,6 +54,8 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=autoscaling.x-k8s.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("provisioningclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Autoscaling().V1().ProvisioningClasses().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("provisioningrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Autoscaling().V1().ProvisioningRequests().Informer()}, nil
 
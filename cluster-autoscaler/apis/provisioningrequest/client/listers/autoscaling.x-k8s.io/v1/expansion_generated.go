@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1
 
+// ProvisioningClassListerExpansion allows custom methods to be added to
+// ProvisioningClassLister.
+type ProvisioningClassListerExpansion interface{}
+
 // ProvisioningRequestListerExpansion allows custom methods to be added to
 // ProvisioningRequestLister.
 type ProvisioningRequestListerExpansion interface{}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// cacheSyncCheckTimeout is how long a health check waits for the cache to report
+// whether its informers have synced.
+const cacheSyncCheckTimeout = 100 * time.Millisecond
+
+// cacheSyncWaiter is the part of the manager cache the health check uses.
+type cacheSyncWaiter interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// cacheHealthCheck reports through the health check endpoints whether the informers
+// of the manager cache have synced. It doesn't start any informers itself: the
+// cache only holds the informers of the components reading through it.
+type cacheHealthCheck struct {
+	mutex          sync.Mutex
+	cache          cacheSyncWaiter
+	unsyncedSince  time.Time
+	maxStartupTime time.Duration
+}
+
+// newCacheHealthCheck creates a cacheHealthCheck that reports the cache as unhealthy
+// once its informers haven't synced for more than maxStartupTime.
+func newCacheHealthCheck(cache cacheSyncWaiter, maxStartupTime time.Duration) *cacheHealthCheck {
+	return &cacheHealthCheck{
+		cache:          cache,
+		maxStartupTime: maxStartupTime,
+	}
+}
+
+// check returns an error if the informers haven't synced for more than the max
+// startup time.
+func (c *cacheHealthCheck) check(now time.Time) error {
+	return c.checkWithGracePeriod(now, c.maxStartupTime)
+}
+
+// healthz is a healthz.Checker failing if the informers haven't synced for more than
+// the max startup time, after which restarting the autoscaler may help.
+func (c *cacheHealthCheck) healthz(_ *http.Request) error {
+	return c.check(time.Now())
+}
+
+// readyz is a healthz.Checker failing while the informers haven't synced.
+func (c *cacheHealthCheck) readyz(_ *http.Request) error {
+	return c.checkWithGracePeriod(time.Now(), 0)
+}
+
+// checkWithGracePeriod returns an error if the informers haven't synced for more
+// than the grace period. The period starts when a check first finds them unsynced,
+// so informers added after startup, e.g. once a replica becomes the leader, get
+// the full grace period too.
+func (c *cacheHealthCheck) checkWithGracePeriod(now time.Time, gracePeriod time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cacheSyncCheckTimeout)
+	defer cancel()
+	synced := c.cache.WaitForCacheSync(ctx)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if synced {
+		c.unsyncedSince = time.Time{}
+		return nil
+	}
+	if c.unsyncedSince.IsZero() {
+		c.unsyncedSince = now
+	}
+	if gracePeriod > 0 && now.Sub(c.unsyncedSince) <= gracePeriod {
+		return nil
+	}
+	return fmt.Errorf("informers of the manager cache haven't synced for %v", now.Sub(c.unsyncedSince))
+}
+
+// wrap returns a handler failing while the informers haven't synced and calling
+// next otherwise.
+func (c *cacheHealthCheck) wrap(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if err := c.check(time.Now()); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("Error: %v", err)))
+			return
+		}
+		next(w, req)
+	}
+}
+
+// missingCRDs returns the kinds of the objects whose CustomResourceDefinitions
+// aren't installed.
+func missingCRDs(mapper meta.RESTMapper, scheme *runtime.Scheme, objects []client.Object) ([]string, error) {
+	var missing []string
+	for _, obj := range objects {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+		_, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			missing = append(missing, gvk.Kind)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get REST mapping for %s: %v", gvk.Kind, err)
+		}
+	}
+	return missing, nil
+}
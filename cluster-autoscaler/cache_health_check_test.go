@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cbv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1beta1"
+	cqv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacityquota/autoscaling.x-k8s.io/v1beta1"
+	provreqv1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	"sigs.k8s.io/cluster-autoscaler/pkg/config"
+)
+
+type fakeCacheSyncWaiter struct {
+	synced bool
+}
+
+func (f *fakeCacheSyncWaiter) WaitForCacheSync(_ context.Context) bool {
+	return f.synced
+}
+
+func TestCacheHealthCheck(t *testing.T) {
+	start := time.Now()
+	cache := &fakeCacheSyncWaiter{}
+	c := newCacheHealthCheck(cache, time.Minute)
+
+	// The grace period starts at the first check finding the cache unsynced.
+	assert.NoError(t, c.check(start))
+	assert.Error(t, c.readyz(nil))
+	assert.NoError(t, c.check(start.Add(time.Minute)))
+	assert.Error(t, c.check(start.Add(2*time.Minute)))
+
+	cache.synced = true
+	assert.NoError(t, c.check(start.Add(2*time.Minute)))
+	assert.NoError(t, c.readyz(nil))
+
+	// Informers added later, e.g. on becoming the leader, get a new grace period.
+	cache.synced = false
+	assert.NoError(t, c.check(start.Add(10*time.Minute)))
+	assert.NoError(t, c.check(start.Add(11*time.Minute)))
+	assert.Error(t, c.check(start.Add(12*time.Minute)))
+}
+
+func TestCacheHealthCheckWrap(t *testing.T) {
+	cache := &fakeCacheSyncWaiter{}
+	c := newCacheHealthCheck(cache, 0)
+	handler := c.wrap(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/health-check", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	cache.synced = true
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, "/health-check", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestMissingCRDs(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(cqv1beta1.GroupVersion.WithKind("CapacityQuota"), meta.RESTScopeRoot)
+	objects := []client.Object{
+		&cqv1beta1.CapacityQuota{},
+		&cbv1beta1.CapacityBuffer{},
+		&provreqv1.ProvisioningRequest{},
+	}
+
+	missing, err := missingCRDs(mapper, scheme, objects)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CapacityBuffer", "ProvisioningRequest"}, missing)
+}
+
+func TestCRDObjects(t *testing.T) {
+	testCases := []struct {
+		name  string
+		opts  config.AutoscalingOptions
+		kinds []string
+	}{
+		{
+			name: "no CRD features",
+		},
+		{
+			name:  "capacity quotas",
+			opts:  config.AutoscalingOptions{CapacityQuotasEnabled: true},
+			kinds: []string{"CapacityQuota"},
+		},
+		{
+			name:  "capacity buffer controller",
+			opts:  config.AutoscalingOptions{CapacityBufferControllerEnabled: true},
+			kinds: []string{"CapacityBuffer"},
+		},
+		{
+			name:  "capacity buffer pod injection",
+			opts:  config.AutoscalingOptions{CapacityBufferPodInjectionEnabled: true},
+			kinds: []string{"CapacityBuffer"},
+		},
+		{
+			name: "all CRD features",
+			opts: config.AutoscalingOptions{
+				CapacityQuotasEnabled:             true,
+				CapacityBufferControllerEnabled:   true,
+				CapacityBufferPodInjectionEnabled: true,
+				ProvisioningRequestEnabled:        true,
+			},
+			kinds: []string{"CapacityQuota", "CapacityBuffer", "ProvisioningRequest"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// With no CRDs installed, missingCRDs returns the kinds of all objects.
+			missing, err := missingCRDs(meta.NewDefaultRESTMapper(nil), scheme, crdObjects(tc.opts))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.kinds, missing)
+		})
+	}
+}
@@ -136,12 +136,9 @@ func (as *AgentPool) Id() string {
 	return as.Name
 }
 
+// getVMsFromCache returns the VMs of the agent pool, none if it's scaled to zero.
 func (as *AgentPool) getVMsFromCache() ([]*armcompute.VirtualMachine, error) {
-	allVMs := as.manager.azureCache.getVirtualMachines()
-	if _, exists := allVMs[as.Name]; !exists {
-		return nil, fmt.Errorf("could not find VMs with poolName: %s", as.Name)
-	}
-	return allVMs[as.Name], nil
+	return as.manager.azureCache.getVirtualMachines()[as.Name], nil
 }
 
 // GetVMIndexes gets indexes of all virtual machines belonging to the agent pool.
@@ -320,14 +317,18 @@ func (as *AgentPool) IncreaseSize(delta int) error {
 		return fmt.Errorf("size increase too large - desired:%d max:%d", curSize+delta, as.MaxSize())
 	}
 
-	highestUsedIndex := indexes[len(indexes)-1]
 	expectedSize := curSize + delta
 	countForTemplate := expectedSize
-	if highestUsedIndex != 0 {
-		countForTemplate += highestUsedIndex + 1 - curSize
+	offset := 0
+	if len(indexes) > 0 {
+		highestUsedIndex := indexes[len(indexes)-1]
+		if highestUsedIndex != 0 {
+			countForTemplate += highestUsedIndex + 1 - curSize
+		}
+		offset = highestUsedIndex + 1
 	}
 	as.parameters[as.Name+"Count"] = map[string]int{"value": countForTemplate}
-	as.parameters[as.Name+"Offset"] = map[string]int{"value": highestUsedIndex + 1}
+	as.parameters[as.Name+"Offset"] = map[string]int{"value": offset}
 
 	newDeploymentName := fmt.Sprintf("cluster-autoscaler-%d", rand.New(rand.NewSource(time.Now().UnixNano())).Int31())
 	newDeployment := armresources.Deployment{
@@ -503,7 +504,25 @@ func (as *AgentPool) Debug() string {
 
 // TemplateNodeInfo returns a node template for this agent pool.
 func (as *AgentPool) TemplateNodeInfo() (*framework.NodeInfo, error) {
-	return nil, cloudprovider.ErrNotImplemented
+	vms, err := as.getVMsFromCache()
+	if err != nil {
+		return nil, err
+	}
+	// Scaled to zero, the pool has no VMs and the template only comes from the deployment parameters.
+	var vm *armcompute.VirtualMachine
+	if len(vms) > 0 {
+		vm = vms[0]
+	}
+	template, err := buildNodeTemplateFromAgentPool(as.Name, as.parameters, vm, as.manager.config.Location)
+	if err != nil {
+		return nil, err
+	}
+	template.AcceleratedNetworking = isAcceleratedNetworkingSupported(as.manager, template.SkuName)
+	node, err := buildNodeFromTemplate(as.Name, template, as.manager, as.manager.config.EnableDynamicInstanceList, false)
+	if err != nil {
+		return nil, err
+	}
+	return framework.NewNodeInfo(node, nil, framework.NewPodInfo(cloudprovider.BuildKubeProxy(as.Name), nil)), nil
 }
 
 // Nodes returns a list of all nodes that belong to this node group.
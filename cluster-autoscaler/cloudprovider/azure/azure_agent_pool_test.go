@@ -24,6 +24,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v7"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/Azure/skewer/v2"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	apiv1 "k8s.io/api/core/v1"
@@ -188,6 +189,21 @@ func TestAgentPoolGetVMsFromCache(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAgentPoolTemplateNodeInfo(t *testing.T) {
+	manager := newTestSKUManager(t, newTestSKU("Standard_DS2_v2", "eastus", map[string]string{skewer.AcceleratedNetworking: "True"}))
+	as := newTestAgentPool(manager, "agentpool1")
+	as.parameters["agentpool1VMSize"] = map[string]interface{}{"value": "Standard_DS2_v2"}
+
+	nodeInfo, err := as.TemplateNodeInfo()
+	assert.NoError(t, err)
+	assert.NotNil(t, nodeInfo)
+	assert.NotEmpty(t, nodeInfo.Pods())
+	node := nodeInfo.Node()
+	assert.Equal(t, "agentpool1", node.Labels[legacyAgentPoolNodeLabelKey])
+	assert.Equal(t, "true", node.Labels[acceleratedNetworkingLabelKey])
+	assert.Equal(t, int64(2), node.Status.Capacity.Cpu().Value())
+}
+
 func TestGetVMIndexes(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -301,6 +317,26 @@ func TestAgentPoolIncreaseSize(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAgentPoolIncreaseSizeFromZero(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	as := newTestAgentPool(newTestAzureManager(t), "empty")
+	as.curSize = 0
+	mockVMClient := NewMockInterface(ctrl)
+	as.manager.azClient.virtualMachinesClient = mockVMClient
+	mockVMClient.EXPECT().List(gomock.Any(), as.manager.config.ResourceGroup).Return(getExpectedVMs(), nil).AnyTimes()
+	as.manager.config.VMType = providerazureconsts.VMTypeStandard
+	ac, err := newAzureCache(as.manager.azClient, refreshInterval, *as.manager.config)
+	assert.NoError(t, err)
+	as.manager.azureCache = ac
+
+	err = as.IncreaseSize(2)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"value": 2}, as.parameters["emptyCount"])
+	assert.Equal(t, map[string]int{"value": 0}, as.parameters["emptyOffset"])
+}
+
 func TestAgentPoolDecreaseTargetSize(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
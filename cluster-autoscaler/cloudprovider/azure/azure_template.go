@@ -84,6 +84,9 @@ const (
 
 	// Cluster node label
 	clusterLabelKey = AKSLabelKeyPrefixValue + "cluster"
+
+	// Accelerated networking node label
+	acceleratedNetworkingLabelKey = AKSLabelKeyPrefixValue + "accelerated-networking-enabled"
 )
 
 // VMPoolNodeTemplate holds properties for node from VMPool
@@ -92,6 +95,7 @@ type VMPoolNodeTemplate struct {
 	Taints        []apiv1.Taint
 	Labels        map[string]*string
 	OSDiskType    *armcontainerservice.OSDiskType
+	OSDiskSizeGB  *int32
 }
 
 // VMSSNodeTemplate holds properties for node from VMSS
@@ -104,13 +108,14 @@ type VMSSNodeTemplate struct {
 
 // NodeTemplate represents a template for an Azure node
 type NodeTemplate struct {
-	SkuName            string
-	Architecture       string
-	InstanceOS         string
-	Location           string
-	Zones              []string
-	VMPoolNodeTemplate *VMPoolNodeTemplate
-	VMSSNodeTemplate   *VMSSNodeTemplate
+	SkuName               string
+	Architecture          string
+	InstanceOS            string
+	Location              string
+	Zones                 []string
+	AcceleratedNetworking bool
+	VMPoolNodeTemplate    *VMPoolNodeTemplate
+	VMSSNodeTemplate      *VMSSNodeTemplate
 }
 
 func buildNodeTemplateFromVMSS(vmss *armcompute.VirtualMachineScaleSet, inputLabels map[string]string, inputTaints string) (NodeTemplate, error) {
@@ -150,9 +155,10 @@ func buildNodeTemplateFromVMSS(vmss *armcompute.VirtualMachineScaleSet, inputLab
 	return NodeTemplate{
 		SkuName: *vmss.SKU.Name,
 
-		Location:   *vmss.Location,
-		Zones:      zones,
-		InstanceOS: instanceOS,
+		Location:              *vmss.Location,
+		Zones:                 zones,
+		InstanceOS:            instanceOS,
+		AcceleratedNetworking: isAcceleratedNetworkingEnabledOnScaleSet(vmss),
 		VMSSNodeTemplate: &VMSSNodeTemplate{
 			InputLabels: inputLabels,
 			InputTaints: inputTaints,
@@ -212,12 +218,108 @@ func buildNodeTemplateFromVMPool(vmsPool armcontainerservice.AgentPool, location
 		VMPoolNodeTemplate: &VMPoolNodeTemplate{
 			AgentPoolName: ptr.Deref(vmsPool.Name, ""),
 			OSDiskType:    vmsPool.Properties.OSDiskType,
+			OSDiskSizeGB:  vmsPool.Properties.OSDiskSizeGB,
 			Taints:        taints,
 			Labels:        labels,
 		},
 	}, nil
 }
 
+// buildNodeTemplateFromAgentPool builds a node template for an aks-engine agent pool. The
+// VM size and zones come from the <pool>VMSize and <pool>AvailabilityZones deployment
+// parameters, the OS and OS disk size from a VM of the pool if it has one.
+func buildNodeTemplateFromAgentPool(poolName string, parameters map[string]interface{}, vm *armcompute.VirtualMachine, location string) (NodeTemplate, error) {
+	var skuName string
+	if value, ok := deploymentParameterValue(parameters, poolName+"VMSize").(string); ok {
+		skuName = value
+	}
+	if skuName == "" && vm != nil && vm.Properties != nil && vm.Properties.HardwareProfile != nil && vm.Properties.HardwareProfile.VMSize != nil {
+		skuName = string(*vm.Properties.HardwareProfile.VMSize)
+	}
+	if skuName == "" {
+		return NodeTemplate{}, fmt.Errorf("agent pool %s has no %sVMSize deployment parameter and no VMs", poolName, poolName)
+	}
+
+	var zones []string
+	if values, ok := deploymentParameterValue(parameters, poolName+"AvailabilityZones").([]interface{}); ok {
+		for _, value := range values {
+			if zone, ok := value.(string); ok {
+				zones = append(zones, zone)
+			}
+		}
+	}
+
+	instanceOS := cloudprovider.DefaultOS
+	var osDiskSizeGB *int32
+	if vm != nil && vm.Properties != nil && vm.Properties.StorageProfile != nil && vm.Properties.StorageProfile.OSDisk != nil {
+		osDisk := vm.Properties.StorageProfile.OSDisk
+		if osDisk.OSType != nil && *osDisk.OSType == armcompute.OperatingSystemTypesWindows {
+			instanceOS = "windows"
+		}
+		osDiskSizeGB = osDisk.DiskSizeGB
+	}
+
+	return NodeTemplate{
+		SkuName:    skuName,
+		Zones:      zones,
+		InstanceOS: instanceOS,
+		Location:   location,
+		VMPoolNodeTemplate: &VMPoolNodeTemplate{
+			AgentPoolName: poolName,
+			OSDiskSizeGB:  osDiskSizeGB,
+			Labels: map[string]*string{
+				legacyAgentPoolNodeLabelKey: ptr.To(poolName),
+			},
+		},
+	}, nil
+}
+
+// deploymentParameterValue returns the value of an ARM deployment parameter, which is
+// either given directly or as {"value": ...}.
+func deploymentParameterValue(parameters map[string]interface{}, name string) interface{} {
+	parameter, ok := parameters[name]
+	if !ok {
+		return nil
+	}
+	if wrapped, ok := parameter.(map[string]interface{}); ok {
+		return wrapped["value"]
+	}
+	return parameter
+}
+
+// isAcceleratedNetworkingSupported returns true if the SKU supports accelerated networking,
+// which AKS and aks-engine enable by default on the SKUs supporting it. It returns false if
+// the SKU isn't in the dynamic SKU list.
+func isAcceleratedNetworkingSupported(manager *AzureManager, skuName string) bool {
+	if !manager.config.EnableDynamicInstanceList || !manager.azureCache.HasVMSKUs() {
+		return false
+	}
+	ctx, cancel := getContextWithTimeout(vmsContextTimeout)
+	defer cancel()
+	sku, err := manager.azureCache.GetSKU(ctx, skuName, manager.config.Location)
+	if err != nil {
+		klog.V(4).Infof("Failed to get SKU %s, skipping accelerated networking label: %v", skuName, err)
+		return false
+	}
+	return sku.IsAcceleratedNetworkingSupported()
+}
+
+// isAcceleratedNetworkingEnabledOnScaleSet returns true if any of the network interfaces
+// in the VMSS model (Uniform or Flexible) has accelerated networking enabled.
+func isAcceleratedNetworkingEnabledOnScaleSet(vmss *armcompute.VirtualMachineScaleSet) bool {
+	if vmss.Properties == nil ||
+		vmss.Properties.VirtualMachineProfile == nil ||
+		vmss.Properties.VirtualMachineProfile.NetworkProfile == nil {
+		return false
+	}
+	for _, nic := range vmss.Properties.VirtualMachineProfile.NetworkProfile.NetworkInterfaceConfigurations {
+		if nic != nil && nic.Properties != nil && ptr.Deref(nic.Properties.EnableAcceleratedNetworking, false) {
+			return true
+		}
+	}
+	return false
+}
+
 func buildNodeFromTemplate(nodeGroupName string, template NodeTemplate, manager *AzureManager, enableDynamicInstanceList bool, enableLabelPrediction bool) (*apiv1.Node, error) {
 	node := apiv1.Node{}
 	nodeName := fmt.Sprintf("%s-asg-%d", nodeGroupName, rand.Int63())
@@ -299,6 +401,22 @@ func buildNodeFromTemplate(nodeGroupName string, template NodeTemplate, manager
 func processVMPoolTemplate(template NodeTemplate, nodeName string, node apiv1.Node) apiv1.Node {
 	labels := buildGenericLabels(template, nodeName)
 	labels[agentPoolNodeLabelKey] = template.VMPoolNodeTemplate.AgentPoolName
+
+	// Add the storage profile label, AKS sets it based on the agentpool OS disk type
+	if template.VMPoolNodeTemplate.OSDiskType != nil {
+		if *template.VMPoolNodeTemplate.OSDiskType == armcontainerservice.OSDiskTypeEphemeral {
+			labels[storageProfileNodeLabelKey] = "ephemeral"
+		} else {
+			labels[storageProfileNodeLabelKey] = "managed"
+		}
+	}
+
+	// If we are on GPU-enabled SKUs, append the accelerator
+	// label so that CA makes better decision when scaling from zero for GPU pools
+	if isNvidiaEnabledSKU(template.SkuName) {
+		labels[GPULabel] = "nvidia"
+	}
+
 	if template.VMPoolNodeTemplate.Labels != nil {
 		for k, v := range template.VMPoolNodeTemplate.Labels {
 			labels[k] = ptr.Deref(v, "")
@@ -306,6 +424,9 @@ func processVMPoolTemplate(template NodeTemplate, nodeName string, node apiv1.No
 	}
 	node.Labels = cloudprovider.JoinStringMaps(node.Labels, labels)
 	node.Spec.Taints = template.VMPoolNodeTemplate.Taints
+
+	// Add ephemeral-storage value
+	setEphemeralStorageFromOSDiskSize(&node, template.VMPoolNodeTemplate.OSDiskSizeGB)
 	return node
 }
 
@@ -375,10 +496,8 @@ func processVMSSTemplate(template NodeTemplate, nodeName string, node apiv1.Node
 	}
 
 	// Add ephemeral-storage value
-	if template.VMSSNodeTemplate.OSDisk != nil && template.VMSSNodeTemplate.OSDisk.DiskSizeGB != nil {
-		node.Status.Capacity[apiv1.ResourceEphemeralStorage] = *resource.NewQuantity(int64(int(*template.VMSSNodeTemplate.OSDisk.DiskSizeGB)*1024*1024*1024), resource.DecimalSI)
-		klog.V(4).Infof("OS Disk Size from template is: %d", *template.VMSSNodeTemplate.OSDisk.DiskSizeGB)
-		klog.V(4).Infof("Setting ephemeral storage to: %v", node.Status.Capacity[apiv1.ResourceEphemeralStorage])
+	if template.VMSSNodeTemplate.OSDisk != nil {
+		setEphemeralStorageFromOSDiskSize(&node, template.VMSSNodeTemplate.OSDisk.DiskSizeGB)
 	}
 
 	// Extract allocatables from tags
@@ -402,6 +521,17 @@ func processVMSSTemplate(template NodeTemplate, nodeName string, node apiv1.Node
 	return node
 }
 
+// setEphemeralStorageFromOSDiskSize sets the ephemeral-storage capacity of the node
+// to the size of its OS disk, if known.
+func setEphemeralStorageFromOSDiskSize(node *apiv1.Node, diskSizeGB *int32) {
+	if diskSizeGB == nil {
+		return
+	}
+	node.Status.Capacity[apiv1.ResourceEphemeralStorage] = *resource.NewQuantity(int64(*diskSizeGB)*1024*1024*1024, resource.DecimalSI)
+	klog.V(4).Infof("OS Disk Size from template is: %d", *diskSizeGB)
+	klog.V(4).Infof("Setting ephemeral storage to: %v", node.Status.Capacity[apiv1.ResourceEphemeralStorage])
+}
+
 func buildGenericLabels(template NodeTemplate, nodeName string) map[string]string {
 	result := make(map[string]string)
 
@@ -436,6 +566,10 @@ func buildGenericLabels(template NodeTemplate, nodeName string) map[string]strin
 		result[azureDiskTopologyKey] = ""
 	}
 
+	if template.AcceleratedNetworking {
+		result[acceleratedNetworkingLabelKey] = "true"
+	}
+
 	result[apiv1.LabelHostname] = nodeName
 	return result
 }
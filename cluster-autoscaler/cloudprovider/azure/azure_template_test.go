@@ -23,12 +23,14 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v7"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v8"
+	"github.com/Azure/skewer/v2"
 	"github.com/stretchr/testify/assert"
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	kubeletapis "k8s.io/kubelet/pkg/apis"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
 )
 
 // realGetInstanceTypeStatically captures the real implementation before any test can override it.
@@ -441,3 +443,164 @@ func TestBuildNodeFromTemplateWithEphemeralStorage(t *testing.T) {
 	assert.True(t, exists)
 	assert.Equal(t, expectedEphemeralStorage.String(), ephemeralStorage.String())
 }
+
+func TestBuildNodeFromVMPoolTemplate(t *testing.T) {
+	osDiskType := armcontainerservice.OSDiskTypeEphemeral
+	diskSizeGB := int32(64)
+	skuName := "Standard_NC6s_v3"
+
+	vmpool := armcontainerservice.AgentPool{
+		Name: ptr.To("gpupool"),
+		Properties: &armcontainerservice.ManagedClusterAgentPoolProfileProperties{
+			OSType:            ptr.To(armcontainerservice.OSTypeLinux),
+			OSDiskType:        &osDiskType,
+			OSDiskSizeGB:      &diskSizeGB,
+			AvailabilityZones: []*string{ptr.To("1"), ptr.To("2")},
+		},
+	}
+
+	template, err := buildNodeTemplateFromVMPool(vmpool, "eastus", skuName, map[string]string{}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &diskSizeGB, template.VMPoolNodeTemplate.OSDiskSizeGB)
+	manager := newTestSKUManager(t, newTestSKU(skuName, "eastus", map[string]string{skewer.AcceleratedNetworking: "True"}))
+	template.AcceleratedNetworking = isAcceleratedNetworkingSupported(manager, skuName)
+
+	node, err := buildNodeFromTemplate("gpupool", template, manager, false, false)
+	assert.NoError(t, err)
+	assert.NotNil(t, node)
+
+	assert.Equal(t, "gpupool", node.Labels[agentPoolNodeLabelKey])
+	assert.Equal(t, "ephemeral", node.Labels[storageProfileNodeLabelKey])
+	assert.Equal(t, "nvidia", node.Labels[GPULabel])
+	assert.Equal(t, "true", node.Labels[acceleratedNetworkingLabelKey])
+	assert.Contains(t, []string{"eastus-1", "eastus-2"}, node.Labels[apiv1.LabelTopologyZone])
+
+	expectedEphemeralStorage := resource.NewQuantity(int64(diskSizeGB)*1024*1024*1024, resource.DecimalSI)
+	ephemeralStorage, exists := node.Status.Capacity[apiv1.ResourceEphemeralStorage]
+	assert.True(t, exists)
+	assert.Equal(t, expectedEphemeralStorage.String(), ephemeralStorage.String())
+	gpuCapacity := node.Status.Capacity["nvidia.com/gpu"]
+	assert.Equal(t, int64(1), gpuCapacity.Value())
+}
+
+func TestAcceleratedNetworkingFromScaleSet(t *testing.T) {
+	testSkuName := "Standard_DS2_v2"
+	for _, enabled := range []bool{true, false} {
+		vmss := &armcompute.VirtualMachineScaleSet{
+			SKU: &armcompute.SKU{Name: &testSkuName},
+			Properties: &armcompute.VirtualMachineScaleSetProperties{
+				OrchestrationMode: ptr.To(armcompute.OrchestrationModeFlexible),
+				VirtualMachineProfile: &armcompute.VirtualMachineScaleSetVMProfile{
+					NetworkProfile: &armcompute.VirtualMachineScaleSetNetworkProfile{
+						NetworkInterfaceConfigurations: []*armcompute.VirtualMachineScaleSetNetworkConfiguration{
+							{
+								Name: ptr.To("nic"),
+								Properties: &armcompute.VirtualMachineScaleSetNetworkConfigurationProperties{
+									EnableAcceleratedNetworking: ptr.To(enabled),
+								},
+							},
+						},
+					},
+				},
+			},
+			Location: ptr.To("westus"),
+		}
+
+		template, err := buildNodeTemplateFromVMSS(vmss, map[string]string{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, enabled, template.AcceleratedNetworking)
+
+		labels := buildGenericLabels(template, "test-node")
+		_, found := labels[acceleratedNetworkingLabelKey]
+		assert.Equal(t, enabled, found)
+	}
+}
+
+// newTestSKU returns a VM SKU offered in the location with the given capabilities.
+func newTestSKU(name, location string, capabilities map[string]string) skewer.SKU {
+	sku := skewer.SKU{
+		Name:         ptr.To(name),
+		ResourceType: ptr.To(skewer.VirtualMachines),
+		Locations:    []*string{ptr.To(location)},
+	}
+	for capability, value := range capabilities {
+		sku.Capabilities = append(sku.Capabilities, &armcompute.ResourceSKUCapabilities{
+			Name:  ptr.To(capability),
+			Value: ptr.To(value),
+		})
+	}
+	return sku
+}
+
+// newTestSKUManager returns a manager in eastus whose dynamic SKU list holds the given SKUs.
+func newTestSKUManager(t *testing.T, skus ...skewer.SKU) *AzureManager {
+	cache, err := skewer.NewStaticCache(skus)
+	assert.NoError(t, err)
+	config := &Config{EnableDynamicInstanceList: true}
+	config.Location = "eastus"
+	return &AzureManager{
+		config:     config,
+		azureCache: &azureCache{skus: cache},
+	}
+}
+
+func TestIsAcceleratedNetworkingSupported(t *testing.T) {
+	manager := newTestSKUManager(t,
+		newTestSKU("Standard_D2s_v3", "eastus", map[string]string{skewer.AcceleratedNetworking: "True"}),
+		newTestSKU("Standard_A1", "eastus", map[string]string{skewer.AcceleratedNetworking: "False"}),
+		newTestSKU("Standard_D4s_v3", "westus", map[string]string{skewer.AcceleratedNetworking: "True"}),
+	)
+	assert.True(t, isAcceleratedNetworkingSupported(manager, "Standard_D2s_v3"))
+	assert.False(t, isAcceleratedNetworkingSupported(manager, "Standard_A1"))
+	// Not offered in the location of the cluster.
+	assert.False(t, isAcceleratedNetworkingSupported(manager, "Standard_D4s_v3"))
+
+	manager.config.EnableDynamicInstanceList = false
+	assert.False(t, isAcceleratedNetworkingSupported(manager, "Standard_D2s_v3"))
+}
+
+func TestBuildNodeFromAgentPoolTemplate(t *testing.T) {
+	parameters := map[string]interface{}{
+		"agentpool1VMSize":            map[string]interface{}{"value": "Standard_DS2_v2"},
+		"agentpool1AvailabilityZones": map[string]interface{}{"value": []interface{}{"1", "2"}},
+	}
+
+	t.Run("scaled to zero", func(t *testing.T) {
+		template, err := buildNodeTemplateFromAgentPool("agentpool1", parameters, nil, "eastus")
+		assert.NoError(t, err)
+		assert.Equal(t, "Standard_DS2_v2", template.SkuName)
+		assert.Equal(t, []string{"1", "2"}, template.Zones)
+		assert.Equal(t, cloudprovider.DefaultOS, template.InstanceOS)
+
+		node, err := buildNodeFromTemplate("agentpool1", template, &AzureManager{}, false, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "agentpool1", node.Labels[legacyAgentPoolNodeLabelKey])
+		assert.Equal(t, "agentpool1", node.Labels[agentPoolNodeLabelKey])
+		assert.Contains(t, []string{"eastus-1", "eastus-2"}, node.Labels[apiv1.LabelTopologyZone])
+		assert.Equal(t, int64(2), node.Status.Capacity.Cpu().Value())
+	})
+
+	t.Run("OS and disk from a VM of the pool", func(t *testing.T) {
+		vm := &armcompute.VirtualMachine{
+			Properties: &armcompute.VirtualMachineProperties{
+				HardwareProfile: &armcompute.HardwareProfile{VMSize: ptr.To(armcompute.VirtualMachineSizeTypesStandardD4SV3)},
+				StorageProfile: &armcompute.StorageProfile{
+					OSDisk: &armcompute.OSDisk{
+						OSType:     ptr.To(armcompute.OperatingSystemTypesWindows),
+						DiskSizeGB: ptr.To(int32(128)),
+					},
+				},
+			},
+		}
+		template, err := buildNodeTemplateFromAgentPool("agentpool2", map[string]interface{}{}, vm, "eastus")
+		assert.NoError(t, err)
+		assert.Equal(t, "Standard_D4s_v3", template.SkuName)
+		assert.Equal(t, "windows", template.InstanceOS)
+		assert.Equal(t, ptr.To(int32(128)), template.VMPoolNodeTemplate.OSDiskSizeGB)
+	})
+
+	t.Run("no VM size", func(t *testing.T) {
+		_, err := buildNodeTemplateFromAgentPool("agentpool3", parameters, nil, "eastus")
+		assert.Error(t, err)
+	})
+}
@@ -496,6 +496,7 @@ func (vmPool *VMPool) TemplateNodeInfo() (*framework.NodeInfo, error) {
 	if err != nil {
 		return nil, err
 	}
+	template.AcceleratedNetworking = isAcceleratedNetworkingSupported(vmPool.manager, vmPool.sku)
 	node, err := buildNodeFromTemplate(vmPool.agentPoolName, template, vmPool.manager, vmPool.manager.config.EnableDynamicInstanceList, false)
 	if err != nil {
 		return nil, err
@@ -32,6 +32,9 @@ type clusterAPIAutoDiscoveryConfig struct {
 	clusterName   string
 	namespace     string
 	labelSelector labels.Selector
+	// managementCluster limits the spec to the named management cluster,
+	// the spec applies to all management clusters when empty.
+	managementCluster string
 }
 
 func parseAutoDiscoverySpec(spec string) (*clusterAPIAutoDiscoveryConfig, error) {
@@ -63,6 +66,8 @@ func parseAutoDiscoverySpec(spec string) (*clusterAPIAutoDiscoveryConfig, error)
 			cfg.clusterName = v
 		case autoDiscovererNamespaceKey:
 			cfg.namespace = v
+		case autoDiscovererManagementClusterKey:
+			cfg.managementCluster = v
 		default:
 			req, err := labels.NewRequirement(k, selection.Equals, []string{v})
 			if err != nil {
@@ -86,6 +91,18 @@ func parseAutoDiscovery(specs []string) ([]*clusterAPIAutoDiscoveryConfig, error
 	return result, nil
 }
 
+// autoDiscoverySpecsForManagementCluster returns the specs which apply to the
+// named management cluster.
+func autoDiscoverySpecsForManagementCluster(specs []*clusterAPIAutoDiscoveryConfig, managementCluster string) []*clusterAPIAutoDiscoveryConfig {
+	result := make([]*clusterAPIAutoDiscoveryConfig, 0, len(specs))
+	for _, spec := range specs {
+		if spec.managementCluster == "" || spec.managementCluster == managementCluster {
+			result = append(result, spec)
+		}
+	}
+	return result
+}
+
 func allowedByAutoDiscoverySpec(spec *clusterAPIAutoDiscoveryConfig, r *unstructured.Unstructured) bool {
 	switch {
 	case spec.namespace != "" && spec.namespace != r.GetNamespace():
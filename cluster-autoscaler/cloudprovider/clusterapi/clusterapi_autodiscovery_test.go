@@ -135,6 +135,15 @@ func Test_parseAutoDiscoverySpec(t *testing.T) {
 			labelSelector: labels.SelectorFromSet(labels.Set{"color": "blue"}),
 		},
 		wantErr: false,
+	}, {
+		name: "management cluster and namespace given",
+		spec: "clusterapi:managementCluster=blue,namespace=test",
+		want: &clusterAPIAutoDiscoveryConfig{
+			namespace:         "test",
+			managementCluster: "blue",
+			labelSelector:     labels.NewSelector(),
+		},
+		wantErr: false,
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			got, err := parseAutoDiscoverySpec(tc.spec)
@@ -195,6 +204,38 @@ func Test_parseAutoDiscovery(t *testing.T) {
 	}
 }
 
+func Test_autoDiscoverySpecsForManagementCluster(t *testing.T) {
+	all := &clusterAPIAutoDiscoveryConfig{namespace: "all"}
+	blue := &clusterAPIAutoDiscoveryConfig{namespace: "blue", managementCluster: "blue"}
+	green := &clusterAPIAutoDiscoveryConfig{namespace: "green", managementCluster: "green"}
+	specs := []*clusterAPIAutoDiscoveryConfig{all, blue, green}
+
+	for _, tc := range []struct {
+		name              string
+		managementCluster string
+		want              []*clusterAPIAutoDiscoveryConfig
+	}{{
+		name:              "unnamed management cluster",
+		managementCluster: "",
+		want:              []*clusterAPIAutoDiscoveryConfig{all},
+	}, {
+		name:              "named management cluster",
+		managementCluster: "blue",
+		want:              []*clusterAPIAutoDiscoveryConfig{all, blue},
+	}, {
+		name:              "unknown management cluster",
+		managementCluster: "red",
+		want:              []*clusterAPIAutoDiscoveryConfig{all},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := autoDiscoverySpecsForManagementCluster(specs, tc.managementCluster)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("autoDiscoverySpecsForManagementCluster() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func Test_allowedByAutoDiscoverySpec(t *testing.T) {
 	for _, tc := range []struct {
 		name                string
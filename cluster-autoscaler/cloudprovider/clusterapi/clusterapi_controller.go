@@ -17,11 +17,15 @@ limitations under the License.
 package clusterapi
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,14 +33,18 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	kubeinformers "k8s.io/client-go/informers"
 	kubeclient "k8s.io/client-go/kubernetes"
+	resourcelisters "k8s.io/client-go/listers/resource/v1"
 	"k8s.io/client-go/scale"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	klog "k8s.io/klog/v2"
 
 	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
@@ -50,49 +58,89 @@ const (
 	// CAPIGroupEnvVar contains the environment variable name which allows overriding defaultCAPIGroup.
 	CAPIGroupEnvVar = "CAPI_GROUP"
 	// CAPIVersionEnvVar contains the environment variable name which allows overriding the Cluster API group version.
-	CAPIVersionEnvVar             = "CAPI_VERSION"
-	resourceNameMachine           = "machines"
-	resourceNameMachineSet        = "machinesets"
-	resourceNameMachineDeployment = "machinedeployments"
-	resourceNameMachinePool       = "machinepools"
-	deletingMachinePrefix         = "deleting-machine-"
-	failedMachinePrefix           = "failed-machine-"
-	pendingMachinePrefix          = "pending-machine-"
-	machineTemplateKind           = "MachineTemplate"
-	machineDeploymentKind         = "MachineDeployment"
-	machineSetKind                = "MachineSet"
-	machinePoolKind               = "MachinePool"
-	machineKind                   = "Machine"
-	autoDiscovererTypeClusterAPI  = "clusterapi"
-	autoDiscovererClusterNameKey  = "clusterName"
-	autoDiscovererNamespaceKey    = "namespace"
+	CAPIVersionEnvVar                  = "CAPI_VERSION"
+	resourceNameMachine                = "machines"
+	resourceNameMachineSet             = "machinesets"
+	resourceNameMachineDeployment      = "machinedeployments"
+	resourceNameMachinePool            = "machinepools"
+	resourceNameMachineHealthCheck     = "machinehealthchecks"
+	deletingMachinePrefix              = "deleting-machine-"
+	failedMachinePrefix                = "failed-machine-"
+	pendingMachinePrefix               = "pending-machine-"
+	machineTemplateKind                = "MachineTemplate"
+	machineDeploymentKind              = "MachineDeployment"
+	machineSetKind                     = "MachineSet"
+	machinePoolKind                    = "MachinePool"
+	machineKind                        = "Machine"
+	autoDiscovererTypeClusterAPI       = "clusterapi"
+	autoDiscovererClusterNameKey       = "clusterName"
+	autoDiscovererNamespaceKey         = "namespace"
+	autoDiscovererManagementClusterKey = "managementCluster"
+	// managementClusterSeparator separates the management cluster name from
+	// the namespace in the normalized provider IDs of machines which do not
+	// have a node, it cannot be part of a namespace or machine name.
+	managementClusterSeparator = "@"
 
 	machinePhaseFailed = "Failed"
+
+	// machineOwnerRemediatedCondition is set to False by a MachineHealthCheck
+	// when it has asked the owner of a Machine to remediate it.
+	machineOwnerRemediatedCondition = "OwnerRemediated"
+	// machineHealthCheckSucceededCondition is set to False by a
+	// MachineHealthCheck when a Machine has failed its health checks.
+	machineHealthCheckSucceededCondition = "HealthCheckSucceeded"
 )
 
+// machineHealthCheckSyncTimeout is how long the controller waits for the
+// MachineHealthCheck informer to sync on startup, it is a variable so that
+// tests can shorten it.
+var machineHealthCheckSyncTimeout = 30 * time.Second
+
+// cacheSyncTimeout is how long the controller waits for the informers of the
+// Cluster API resources to sync on startup, so that an unreachable management
+// cluster can be skipped.
+var cacheSyncTimeout = 5 * time.Minute
+
 // machineController watches for Nodes, Machines, MachinePools, MachineSets, and
 // MachineDeployments as they are added, updated and deleted on the
 // cluster. Additionally, it adds indices to the node informers to
 // satisfy lookup by node.Spec.ProviderID.
 type machineController struct {
-	workloadInformerFactory     kubeinformers.SharedInformerFactory
-	managementInformerFactory   dynamicinformer.DynamicSharedInformerFactory
-	machineDeploymentInformer   informers.GenericInformer
-	machineInformer             informers.GenericInformer
-	machineSetInformer          informers.GenericInformer
-	machinePoolInformer         informers.GenericInformer
-	nodeInformer                cache.SharedIndexInformer
-	managementClient            dynamic.Interface
-	managementScaleClient       scale.ScalesGetter
-	managementDiscoveryClient   discovery.DiscoveryInterface
-	machineSetResource          schema.GroupVersionResource
-	machineResource             schema.GroupVersionResource
-	machinePoolResource         schema.GroupVersionResource
-	machinePoolsAvailable       bool
-	machineDeploymentResource   schema.GroupVersionResource
-	machineDeploymentsAvailable bool
-	accessLock                  sync.Mutex
-	autoDiscoverySpecs          []*clusterAPIAutoDiscoveryConfig
+	workloadInformerFactory      kubeinformers.SharedInformerFactory
+	managementInformerFactory    dynamicinformer.DynamicSharedInformerFactory
+	machineDeploymentInformer    informers.GenericInformer
+	machineInformer              informers.GenericInformer
+	machineSetInformer           informers.GenericInformer
+	machinePoolInformer          informers.GenericInformer
+	machineHealthCheckInformer   informers.GenericInformer
+	nodeInformer                 cache.SharedIndexInformer
+	managementClient             dynamic.Interface
+	managementScaleClient        scale.ScalesGetter
+	managementDiscoveryClient    discovery.DiscoveryInterface
+	machineSetResource           schema.GroupVersionResource
+	machineResource              schema.GroupVersionResource
+	machinePoolResource          schema.GroupVersionResource
+	machinePoolsAvailable        bool
+	machineDeploymentResource    schema.GroupVersionResource
+	machineDeploymentsAvailable  bool
+	machineHealthCheckResource   schema.GroupVersionResource
+	machineHealthChecksAvailable bool
+	accessLock                   sync.Mutex
+	autoDiscoverySpecs           []*clusterAPIAutoDiscoveryConfig
+	// managementCluster is the name of the management cluster the controller
+	// watches, it is empty when there is a single management cluster.
+	managementCluster string
+	// eventRecorder records events on the management cluster resources, it
+	// is optional and events are dropped when it is not set.
+	eventRecorder record.EventRecorder
+	// invalidAutoscalingOptions holds the invalid autoscaling options last
+	// reported for each scalable resource, so that they are only reported
+	// again when they change rather than every time the options are read.
+	invalidAutoscalingOptions     map[types.UID]string
+	invalidAutoscalingOptionsLock sync.Mutex
+	// deviceClassLister lists the DRA device classes of the workload cluster,
+	// it is only set when DRA is enabled.
+	deviceClassLister resourcelisters.DeviceClassLister
 	// stopChannel is used for running the shared informers, and for starting
 	// informers associated with infrastructure machine templates that are
 	// discovered during operation.
@@ -228,8 +276,21 @@ func (c *machineController) run() error {
 	}
 
 	klog.V(4).Infof("waiting for caches to sync")
-	if !cache.WaitForCacheSync(c.stopChannel, syncFuncs...) {
-		return fmt.Errorf("syncing caches failed")
+	syncCtx, cancelSync := context.WithTimeout(wait.ContextForChannel(c.stopChannel), cacheSyncTimeout)
+	defer cancelSync()
+	if !cache.WaitForCacheSync(syncCtx.Done(), syncFuncs...) {
+		return fmt.Errorf("syncing caches failed within %v", cacheSyncTimeout)
+	}
+
+	// MachineHealthChecks only refine the detection of remediated machines,
+	// so e.g. missing permissions to watch them must not block the startup.
+	if c.machineHealthChecksAvailable {
+		ctx, cancel := context.WithTimeout(wait.ContextForChannel(c.stopChannel), machineHealthCheckSyncTimeout)
+		defer cancel()
+		if !cache.WaitForCacheSync(ctx.Done(), c.machineHealthCheckInformer.Informer().HasSynced) {
+			klog.Warningf("MachineHealthChecks have not synced within %v, check that the autoscaler can list and watch them; "+
+				"until they sync, only machines whose owner was asked to remediate them are treated as being remediated", machineHealthCheckSyncTimeout)
+		}
 	}
 
 	return nil
@@ -346,6 +407,64 @@ func (c *machineController) findMachineByProviderID(providerID normalizedProvide
 	return c.findMachine(path.Join(ns, machineID))
 }
 
+// isMachineBeingRemediated returns true if a MachineHealthCheck has asked
+// for the machine to be remediated, or if the machine failed its health
+// checks and a MachineHealthCheck targeting it is allowed to remediate it.
+func (c *machineController) isMachineBeingRemediated(machine *unstructured.Unstructured) (bool, error) {
+	if machineHasConditionStatus(machine, machineOwnerRemediatedCondition, string(corev1.ConditionFalse)) {
+		return true, nil
+	}
+
+	if !c.machineHealthChecksAvailable ||
+		!c.machineHealthCheckInformer.Informer().HasSynced() ||
+		!machineHasConditionStatus(machine, machineHealthCheckSucceededCondition, string(corev1.ConditionFalse)) {
+		return false, nil
+	}
+
+	objs, err := c.machineHealthCheckInformer.Lister().ByNamespace(machine.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+
+	for _, obj := range objs {
+		mhc, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return false, fmt.Errorf("internal error; unexpected type %T", obj)
+		}
+
+		targets, _, err := unstructured.NestedStringSlice(mhc.UnstructuredContent(), "status", "targets")
+		if err != nil {
+			return false, err
+		}
+		if !slices.Contains(targets, machine.GetName()) {
+			continue
+		}
+
+		remediationsAllowed, found, err := unstructured.NestedInt64(mhc.UnstructuredContent(), "status", "remediationsAllowed")
+		if err != nil {
+			return false, err
+		}
+		if found && remediationsAllowed > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isNodeBeingRemediated returns true if the machine backing node is being
+// remediated by a MachineHealthCheck.
+func (c *machineController) isNodeBeingRemediated(node *corev1.Node) (bool, error) {
+	machine, err := c.findMachineByProviderID(normalizedProviderString(node.Spec.ProviderID))
+	if err != nil {
+		return false, err
+	}
+	if machine == nil {
+		return false, nil
+	}
+	return c.isMachineBeingRemediated(machine)
+}
+
 func createDeletingMachineNormalizedProviderID(namespace, name string) string {
 	return fmt.Sprintf("%s%s_%s", deletingMachinePrefix, namespace, name)
 }
@@ -356,7 +475,7 @@ func isDeletingMachineProviderID(providerID normalizedProviderID) bool {
 
 func machineKeyFromDeletingMachineProviderID(providerID normalizedProviderID) string {
 	namespaceName := strings.TrimPrefix(string(providerID), deletingMachinePrefix)
-	return strings.Replace(namespaceName, "_", "/", 1)
+	return machineKeyFromNamespaceName(namespaceName)
 }
 
 // createPendingMachineProviderID creates a providerID for a machine that is pending
@@ -370,7 +489,7 @@ func isPendingMachineProviderID(providerID normalizedProviderID) bool {
 
 func machineKeyFromPendingMachineProviderID(providerID normalizedProviderID) string {
 	namespaceName := strings.TrimPrefix(string(providerID), pendingMachinePrefix)
-	return strings.Replace(namespaceName, "_", "/", 1)
+	return machineKeyFromNamespaceName(namespaceName)
 }
 
 func createFailedMachineNormalizedProviderID(namespace, name string) string {
@@ -383,9 +502,47 @@ func isFailedMachineProviderID(providerID normalizedProviderID) bool {
 
 func machineKeyFromFailedProviderID(providerID normalizedProviderID) string {
 	namespaceName := strings.TrimPrefix(string(providerID), failedMachinePrefix)
+	return machineKeyFromNamespaceName(namespaceName)
+}
+
+// machineIDNamespace returns the namespace part of the normalized provider
+// IDs created for the machine. The namespace is qualified with the
+// management cluster name, if any, so that machines with the same name in
+// different management clusters get different IDs.
+func (c *machineController) machineIDNamespace(machine *unstructured.Unstructured) string {
+	if c.managementCluster == "" {
+		return machine.GetNamespace()
+	}
+	return c.managementCluster + managementClusterSeparator + machine.GetNamespace()
+}
+
+// machineKeyFromNamespaceName converts the namespace_name part of a
+// normalized provider ID into a machine key, dropping the management cluster
+// name if present.
+func machineKeyFromNamespaceName(namespaceName string) string {
+	if _, rest, found := strings.Cut(namespaceName, managementClusterSeparator); found {
+		namespaceName = rest
+	}
 	return strings.Replace(namespaceName, "_", "/", 1)
 }
 
+// managementClusterFromProviderID returns the management cluster name
+// carried by a normalized provider ID of a machine without a node. It
+// returns an empty string for any other provider ID.
+func managementClusterFromProviderID(providerID normalizedProviderID) string {
+	if isProviderIDNormalized(providerID) {
+		return ""
+	}
+	id := string(providerID)
+	for _, prefix := range []string{deletingMachinePrefix, pendingMachinePrefix, failedMachinePrefix} {
+		id = strings.TrimPrefix(id, prefix)
+	}
+	if managementCluster, _, found := strings.Cut(id, managementClusterSeparator); found {
+		return managementCluster
+	}
+	return ""
+}
+
 // isProviderIDNormalized determines whether a node's providerID is the standard
 // providerID assigned by the cloud provider, or if it has
 // been modified by the CAS CAPI provider to indicate deleting, pending, or failed
@@ -439,10 +596,69 @@ func getCAPIVersion() string {
 	return v
 }
 
+// recordEvent records an event on a management cluster resource, if the
+// controller has an event recorder.
+func (c *machineController) recordEvent(obj *unstructured.Unstructured, eventType, reason, message string) {
+	if c.eventRecorder == nil {
+		return
+	}
+	c.eventRecorder.Event(obj, eventType, reason, message)
+}
+
+// reportInvalidAutoscalingOptions logs and records events for the errors of
+// the autoscaling options of a scalable resource, unless the same errors were
+// already reported for it.
+func (c *machineController) reportInvalidAutoscalingOptions(id string, obj *unstructured.Unstructured, errs []error) {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	// The errors are in the random order of the options map.
+	sort.Strings(messages)
+	key := strings.Join(messages, "\n")
+
+	c.invalidAutoscalingOptionsLock.Lock()
+	defer c.invalidAutoscalingOptionsLock.Unlock()
+	if c.invalidAutoscalingOptions == nil {
+		c.invalidAutoscalingOptions = map[types.UID]string{}
+	}
+	if len(errs) == 0 {
+		delete(c.invalidAutoscalingOptions, obj.GetUID())
+		return
+	}
+	if reported, found := c.invalidAutoscalingOptions[obj.GetUID()]; found && reported == key {
+		return
+	}
+	c.invalidAutoscalingOptions[obj.GetUID()] = key
+
+	for _, message := range messages {
+		klog.Warningf("ignoring autoscaling option for scalable resource %q: %s", id, message)
+		c.recordEvent(obj, corev1.EventTypeWarning, invalidAutoscalingOptionEventReason, message)
+	}
+}
+
+// forgetInvalidAutoscalingOptions drops the invalid autoscaling options
+// reported for a deleted scalable resource.
+func (c *machineController) forgetInvalidAutoscalingOptions(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	c.invalidAutoscalingOptionsLock.Lock()
+	defer c.invalidAutoscalingOptionsLock.Unlock()
+	delete(c.invalidAutoscalingOptions, u.GetUID())
+}
+
 // newMachineController constructs a controller that watches Nodes,
-// Machines, MachinePools, MachineDeployments, and MachineSets as they are added, updated, and deleted on
+// Machines, MachinePools, MachineDeployments, MachineSets and, when
+// available, MachineHealthChecks as they are added, updated, and deleted on
 // the cluster.
 func newMachineController(
+	managementCluster string,
 	managementClient dynamic.Interface,
 	workloadClient kubeclient.Interface,
 	managementDiscoveryClient discovery.DiscoveryInterface,
@@ -452,10 +668,14 @@ func newMachineController(
 ) (*machineController, error) {
 	workloadInformerFactory := kubeinformers.NewSharedInformerFactory(workloadClient, 0)
 
-	autoDiscoverySpecs, err := parseAutoDiscovery(discoveryOpts.NodeGroupAutoDiscoverySpecs)
+	allAutoDiscoverySpecs, err := parseAutoDiscovery(discoveryOpts.NodeGroupAutoDiscoverySpecs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse auto discovery configuration: %v", err)
 	}
+	autoDiscoverySpecs := autoDiscoverySpecsForManagementCluster(allAutoDiscoverySpecs, managementCluster)
+	if len(allAutoDiscoverySpecs) > 0 && len(autoDiscoverySpecs) == 0 {
+		return nil, fmt.Errorf("no auto discovery configuration applies to management cluster %q", managementCluster)
+	}
 
 	managementInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(managementClient, 0, namespaceToWatch(autoDiscoverySpecs), nil)
 
@@ -526,6 +746,28 @@ func newMachineController(
 		}
 	}
 
+	var gvrMachineHealthCheck schema.GroupVersionResource
+	var machineHealthCheckInformer informers.GenericInformer
+
+	machineHealthChecksAvailable, err := groupVersionHasResource(managementDiscoveryClient,
+		fmt.Sprintf("%s/%s", CAPIGroup, CAPIVersion), resourceNameMachineHealthCheck)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate if resource %q is available for group %q: %v",
+			resourceNameMachineHealthCheck, fmt.Sprintf("%s/%s", CAPIGroup, CAPIVersion), err)
+	}
+
+	if machineHealthChecksAvailable {
+		gvrMachineHealthCheck = schema.GroupVersionResource{
+			Group:    CAPIGroup,
+			Version:  CAPIVersion,
+			Resource: resourceNameMachineHealthCheck,
+		}
+		machineHealthCheckInformer = managementInformerFactory.ForResource(gvrMachineHealthCheck)
+		if _, err := machineHealthCheckInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{}); err != nil {
+			return nil, fmt.Errorf("failed to add event handler for resource %q: %w", resourceNameMachineHealthCheck, err)
+		}
+	}
+
 	gvrMachine := schema.GroupVersionResource{
 		Group:    CAPIGroup,
 		Version:  CAPIVersion,
@@ -553,26 +795,45 @@ func newMachineController(
 		return nil, fmt.Errorf("cannot add node indexer: %v", err)
 	}
 
-	return &machineController{
-		autoDiscoverySpecs:          autoDiscoverySpecs,
-		workloadInformerFactory:     workloadInformerFactory,
-		managementInformerFactory:   managementInformerFactory,
-		machineDeploymentInformer:   machineDeploymentInformer,
-		machineInformer:             machineInformer,
-		machineSetInformer:          machineSetInformer,
-		machinePoolInformer:         machinePoolInformer,
-		nodeInformer:                nodeInformer,
-		managementClient:            managementClient,
-		managementScaleClient:       managementScaleClient,
-		managementDiscoveryClient:   managementDiscoveryClient,
-		machineSetResource:          gvrMachineSet,
-		machinePoolResource:         gvrMachinePool,
-		machinePoolsAvailable:       machinePoolsAvailable,
-		machineResource:             gvrMachine,
-		machineDeploymentResource:   gvrMachineDeployment,
-		machineDeploymentsAvailable: machineDeploymentAvailable,
-		stopChannel:                 stopChannel,
-	}, nil
+	controller := &machineController{
+		autoDiscoverySpecs:           autoDiscoverySpecs,
+		managementCluster:            managementCluster,
+		workloadInformerFactory:      workloadInformerFactory,
+		managementInformerFactory:    managementInformerFactory,
+		machineDeploymentInformer:    machineDeploymentInformer,
+		machineInformer:              machineInformer,
+		machineSetInformer:           machineSetInformer,
+		machinePoolInformer:          machinePoolInformer,
+		machineHealthCheckInformer:   machineHealthCheckInformer,
+		nodeInformer:                 nodeInformer,
+		managementClient:             managementClient,
+		managementScaleClient:        managementScaleClient,
+		managementDiscoveryClient:    managementDiscoveryClient,
+		machineSetResource:           gvrMachineSet,
+		machinePoolResource:          gvrMachinePool,
+		machinePoolsAvailable:        machinePoolsAvailable,
+		machineResource:              gvrMachine,
+		machineDeploymentResource:    gvrMachineDeployment,
+		machineDeploymentsAvailable:  machineDeploymentAvailable,
+		machineHealthCheckResource:   gvrMachineHealthCheck,
+		machineHealthChecksAvailable: machineHealthChecksAvailable,
+		stopChannel:                  stopChannel,
+	}
+
+	// The reported invalid autoscaling options are keyed by the UID of the
+	// scalable resource, forget them once the resource is gone.
+	for _, informer := range []informers.GenericInformer{machineDeploymentInformer, machineSetInformer, machinePoolInformer} {
+		if informer == nil {
+			continue
+		}
+		if _, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			DeleteFunc: controller.forgetInvalidAutoscalingOptions,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to add delete handler for scalable resources: %v", err)
+		}
+	}
+
+	return controller, nil
 }
 
 func groupVersionHasResource(client discovery.DiscoveryInterface, groupVersion, resourceName string) (bool, error) {
@@ -702,7 +963,7 @@ func (c *machineController) findScalableResourceProviderIDs(scalableResource *un
 				// TODO(LucasAndFlores): once we moved to the version 1.15, we should exclude the check for failed machine (lines 684-708), since this state was deprecated.
 				// Ref: https://cluster-api.sigs.k8s.io/developer/providers/migrations/v1.10-to-v1.11#deprecations
 				klog.V(4).Infof("Status.FailureMessage of machine %q is %q", machine.GetName(), failureMessage)
-				providerIDs = append(providerIDs, createFailedMachineNormalizedProviderID(machine.GetNamespace(), machine.GetName()))
+				providerIDs = append(providerIDs, createFailedMachineNormalizedProviderID(c.machineIDNamespace(machine), machine.GetName()))
 				continue
 			}
 		}
@@ -714,7 +975,7 @@ func (c *machineController) findScalableResourceProviderIDs(scalableResource *un
 		// We give these machines normalized provider IDs to aid in the filtering process.
 		if !machine.GetDeletionTimestamp().IsZero() {
 			klog.V(4).Infof("Machine %q has a non-zero deletion timestamp", machine.GetName())
-			providerIDs = append(providerIDs, createDeletingMachineNormalizedProviderID(machine.GetNamespace(), machine.GetName()))
+			providerIDs = append(providerIDs, createDeletingMachineNormalizedProviderID(c.machineIDNamespace(machine), machine.GetName()))
 			continue
 		}
 
@@ -733,7 +994,7 @@ func (c *machineController) findScalableResourceProviderIDs(scalableResource *un
 
 		if !found {
 			klog.V(4).Infof("Status.NodeRef of machine %q is currently nil", machine.GetName())
-			providerIDs = append(providerIDs, createPendingMachineProviderID(machine.GetNamespace(), machine.GetName()))
+			providerIDs = append(providerIDs, createPendingMachineProviderID(c.machineIDNamespace(machine), machine.GetName()))
 			continue
 		}
 
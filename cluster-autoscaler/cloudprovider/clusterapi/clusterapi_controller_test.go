@@ -23,13 +23,19 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-autoscaler/pkg/config"
 )
 
 func TestControllerFindMachine(t *testing.T) {
@@ -240,6 +246,176 @@ func TestControllerFindMachineByProviderID(t *testing.T) {
 	}
 }
 
+func TestControllerIsMachineBeingRemediated(t *testing.T) {
+	condition := func(conditionType, status string) interface{} {
+		return map[string]interface{}{
+			"type":   conditionType,
+			"status": status,
+		}
+	}
+
+	for _, tc := range []struct {
+		description         string
+		conditions          []interface{}
+		v1beta2Conditions   []interface{}
+		remediationsAllowed *int64
+		expected            bool
+	}{
+		{
+			description: "healthy machine",
+			conditions:  []interface{}{condition(machineHealthCheckSucceededCondition, "True")},
+			expected:    false,
+		},
+		{
+			description: "owner asked to remediate",
+			conditions:  []interface{}{condition(machineOwnerRemediatedCondition, "False")},
+			expected:    true,
+		},
+		{
+			description:       "owner asked to remediate in v1beta2 conditions",
+			v1beta2Conditions: []interface{}{condition(machineOwnerRemediatedCondition, "False")},
+			expected:          true,
+		},
+		{
+			description: "unhealthy machine without a MachineHealthCheck",
+			conditions:  []interface{}{condition(machineHealthCheckSucceededCondition, "False")},
+			expected:    false,
+		},
+		{
+			description:         "unhealthy machine and remediation allowed",
+			conditions:          []interface{}{condition(machineHealthCheckSucceededCondition, "False")},
+			remediationsAllowed: ptr.To[int64](1),
+			expected:            true,
+		},
+		{
+			description:         "unhealthy machine and remediation short-circuited",
+			conditions:          []interface{}{condition(machineHealthCheckSucceededCondition, "False")},
+			remediationsAllowed: ptr.To[int64](0),
+			expected:            false,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			testConfig := NewTestConfigBuilder().
+				ForMachineSet().
+				WithNodeCount(1).
+				WithAnnotations(map[string]string{
+					nodeGroupMinSizeAnnotationKey: "1",
+					nodeGroupMaxSizeAnnotationKey: "10",
+				}).
+				Build()
+
+			controller := NewTestMachineController(t)
+			defer controller.Stop()
+			controller.AddTestConfigs(testConfig)
+
+			machine := testConfig.machines[0].DeepCopy()
+			if tc.conditions != nil {
+				if err := unstructured.SetNestedSlice(machine.Object, tc.conditions, "status", "conditions"); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if tc.v1beta2Conditions != nil {
+				if err := unstructured.SetNestedSlice(machine.Object, tc.v1beta2Conditions, "status", "v1beta2", "conditions"); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if err := controller.UpdateResource(controller.machineInformer, controller.machineResource, machine); err != nil {
+				t.Fatalf("unexpected error updating machine, got %v", err)
+			}
+
+			if tc.remediationsAllowed != nil {
+				mhc := &unstructured.Unstructured{
+					Object: map[string]interface{}{
+						"kind":       "MachineHealthCheck",
+						"apiVersion": "cluster.x-k8s.io/v1beta2",
+						"metadata": map[string]interface{}{
+							"name":      "mhc",
+							"namespace": machine.GetNamespace(),
+						},
+						"status": map[string]interface{}{
+							"targets":             []interface{}{machine.GetName()},
+							"remediationsAllowed": *tc.remediationsAllowed,
+						},
+					},
+				}
+				if err := controller.CreateResource(controller.machineHealthCheckInformer, controller.machineHealthCheckResource, mhc); err != nil {
+					t.Fatalf("unexpected error creating machine health check, got %v", err)
+				}
+			}
+
+			remediating, err := controller.isNodeBeingRemediated(testConfig.nodes[0])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if remediating != tc.expected {
+				t.Errorf("expected remediating to be %t, got %t", tc.expected, remediating)
+			}
+		})
+	}
+}
+
+func TestControllerMachineHealthChecksForbidden(t *testing.T) {
+	defer func(timeout time.Duration) { machineHealthCheckSyncTimeout = timeout }(machineHealthCheckSyncTimeout)
+	machineHealthCheckSyncTimeout = 100 * time.Millisecond
+
+	forbidden := func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		if action.GetResource().Resource != resourceNameMachineHealthCheck {
+			return false, nil, nil
+		}
+		return true, nil, apierrors.NewForbidden(action.GetResource().GroupResource(), "", fmt.Errorf("forbidden"))
+	}
+
+	testConfig := NewTestConfigBuilder().
+		ForMachineSet().
+		WithNodeCount(1).
+		WithAnnotations(map[string]string{
+			nodeGroupMinSizeAnnotationKey: "1",
+			nodeGroupMaxSizeAnnotationKey: "10",
+		}).
+		Build()
+
+	// The controller starts although the MachineHealthChecks can't be listed.
+	controller := newTestMachineControllerWithReactors(t, forbidden)
+	defer controller.Stop()
+	controller.AddTestConfigs(testConfig)
+
+	for _, tc := range []struct {
+		description string
+		condition   string
+		expected    bool
+	}{
+		{
+			description: "unhealthy machine",
+			condition:   machineHealthCheckSucceededCondition,
+			expected:    false,
+		},
+		{
+			description: "owner asked to remediate",
+			condition:   machineOwnerRemediatedCondition,
+			expected:    true,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			machine := testConfig.machines[0].DeepCopy()
+			conditions := []interface{}{map[string]interface{}{"type": tc.condition, "status": "False"}}
+			if err := unstructured.SetNestedSlice(machine.Object, conditions, "status", "conditions"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := controller.UpdateResource(controller.machineInformer, controller.machineResource, machine); err != nil {
+				t.Fatalf("unexpected error updating machine, got %v", err)
+			}
+
+			remediating, err := controller.isNodeBeingRemediated(testConfig.nodes[0])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if remediating != tc.expected {
+				t.Errorf("expected remediating to be %t, got %t", tc.expected, remediating)
+			}
+		})
+	}
+}
+
 func TestControllerFindNodeByNodeName(t *testing.T) {
 	testConfig := NewTestConfigBuilder().
 		ForMachineSet().
@@ -1939,6 +2115,11 @@ func Test_machineKeyFromDeletingMachineProviderID(t *testing.T) {
 			providerID:     createDeletingMachineNormalizedProviderID("cluster-api", "id-0001"),
 			expectedReturn: "cluster-api/id-0001",
 		},
+		{
+			description:    "provider ID with deletion prefix and management cluster returns proper provider ID",
+			providerID:     createDeletingMachineNormalizedProviderID("blue@cluster-api", "id-0001"),
+			expectedReturn: "cluster-api/id-0001",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1951,6 +2132,47 @@ func Test_machineKeyFromDeletingMachineProviderID(t *testing.T) {
 	}
 }
 
+func Test_managementClusterFromProviderID(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		providerID  string
+		expected    string
+	}{
+		{
+			description: "real provider ID",
+			providerID:  "fake-provider://a.provider.id@0001",
+			expected:    "",
+		},
+		{
+			description: "pending machine without management cluster",
+			providerID:  createPendingMachineProviderID("cluster-api", "id-0001"),
+			expected:    "",
+		},
+		{
+			description: "pending machine with management cluster",
+			providerID:  createPendingMachineProviderID("blue@cluster-api", "id-0001"),
+			expected:    "blue",
+		},
+		{
+			description: "deleting machine with management cluster",
+			providerID:  createDeletingMachineNormalizedProviderID("green@cluster-api", "id-0001"),
+			expected:    "green",
+		},
+		{
+			description: "failed machine with management cluster",
+			providerID:  createFailedMachineNormalizedProviderID("blue@cluster-api", "id-0001"),
+			expected:    "blue",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			observed := managementClusterFromProviderID(normalizedProviderID(tc.providerID))
+			if observed != tc.expected {
+				t.Fatalf("unexpected management cluster for provider ID %q, expected %q, observed %q", tc.providerID, tc.expected, observed)
+			}
+		})
+	}
+}
+
 func Test_createDeletingMachineNormalizedProviderID(t *testing.T) {
 	type testCase struct {
 		description    string
@@ -2005,3 +2227,52 @@ func Test_createPendingMachineProviderID(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerForgetsInvalidAutoscalingOptionsOfDeletedResources(t *testing.T) {
+	controller := NewTestMachineController(t)
+	defer controller.Stop()
+
+	testConfig := NewTestConfigBuilder().
+		ForMachineSet().
+		WithNamespace(testNamespace).
+		WithNodeCount(1).
+		WithAnnotations(map[string]string{
+			nodeGroupMinSizeAnnotationKey:                        "1",
+			nodeGroupMaxSizeAnnotationKey:                        "10",
+			nodeGroupAutoscalingOptionsKeyPrefix + "notanoption": "1",
+		}).
+		Build()
+	if err := controller.AddTestConfigs(testConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nodegroups, err := controller.nodeGroups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l := len(nodegroups); l != 1 {
+		t.Fatalf("expected 1 nodegroup, got %d", l)
+	}
+	if _, err := nodegroups[0].GetOptions(config.NodeGroupAutoscalingOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reported := func() bool {
+		controller.invalidAutoscalingOptionsLock.Lock()
+		defer controller.invalidAutoscalingOptionsLock.Unlock()
+		_, found := controller.invalidAutoscalingOptions[testConfig.machineSet.GetUID()]
+		return found
+	}
+	if !reported() {
+		t.Fatalf("expected the invalid autoscaling options of %q to be recorded", testConfig.machineSet.GetName())
+	}
+
+	if err := controller.DeleteTestConfigs(testConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := wait.PollUntilContextTimeout(context.Background(), time.Millisecond, fifteenSecondDuration, true, func(_ context.Context) (bool, error) {
+		return !reported(), nil
+	}); err != nil {
+		t.Fatalf("expected the invalid autoscaling options of %q to be forgotten: %v", testConfig.machineSet.GetName(), err)
+	}
+}
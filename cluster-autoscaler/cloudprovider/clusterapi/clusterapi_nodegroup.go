@@ -20,7 +20,6 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
-	"time"
 
 	"k8s.io/klog/v2"
 
@@ -271,6 +270,9 @@ func (ng *nodegroup) DecreaseTargetSize(delta int) error {
 
 // Id returns an unique identifier of the node group.
 func (ng *nodegroup) Id() string {
+	if managementCluster := ng.machineController.managementCluster; managementCluster != "" {
+		return managementCluster + "/" + ng.scalableResource.ID()
+	}
 	return ng.scalableResource.ID()
 }
 
@@ -296,6 +298,9 @@ func (ng *nodegroup) Nodes() ([]cloudprovider.Instance, error) {
 	// must match the ID on the Node object itself.
 	// https://github.com/kubernetes/autoscaler/blob/a973259f1852303ba38a3a61eeee8489cf4e1b13/cluster-autoscaler/clusterstate/clusterstate.go#L967-L985
 	instances := make([]cloudprovider.Instance, len(providerIDs))
+	// replacements are the instances that will replace the machines being
+	// remediated once these are deleted.
+	var replacements []cloudprovider.Instance
 	for i, providerID := range providerIDs {
 		providerIDNormalized := normalizedProviderID(providerID)
 
@@ -363,10 +368,41 @@ func (ng *nodegroup) Nodes() ([]cloudprovider.Instance, error) {
 			}
 
 		default:
-			klog.V(4).Infof("Machine running in node group %s (%s)", ng.Id(), providerID)
 			status = &cloudprovider.InstanceStatus{
 				State: cloudprovider.InstanceRunning,
 			}
+
+			// A MachineHealthCheck may be replacing this machine, report it
+			// as going away so that the autoscaler does not act on it too.
+			// Failing to check a single machine must not fail the whole node
+			// group, so the machine is then reported as running.
+			remediating := false
+			machine, err := ng.machineController.findMachineByProviderID(providerIDNormalized)
+			if err != nil {
+				klog.Warningf("Failed to find machine in node group %s (%s) to check its remediation: %v", ng.Id(), providerID, err)
+			} else if machine != nil {
+				remediating, err = ng.machineController.isMachineBeingRemediated(machine)
+				if err != nil {
+					klog.Warningf("Failed to check remediation of machine in node group %s (%s): %v", ng.Id(), providerID, err)
+				}
+			}
+
+			if remediating {
+				// The machine is going to be deleted and replaced, whether or
+				// not its node has registered. Its owner only creates the
+				// replacement once the machine is deleted, report it as being
+				// created until then so that the autoscaler expects it.
+				klog.V(4).Infof("Machine being remediated in node group %s (%s)", ng.Id(), providerID)
+				status.State = cloudprovider.InstanceDeleting
+				replacements = append(replacements, cloudprovider.Instance{
+					Id: createPendingMachineProviderID(ng.machineController.machineIDNamespace(machine), machine.GetName()),
+					Status: &cloudprovider.InstanceStatus{
+						State: cloudprovider.InstanceCreating,
+					},
+				})
+			} else {
+				klog.V(4).Infof("Machine running in node group %s (%s)", ng.Id(), providerID)
+			}
 		}
 
 		instances[i] = cloudprovider.Instance{
@@ -375,7 +411,7 @@ func (ng *nodegroup) Nodes() ([]cloudprovider.Instance, error) {
 		}
 	}
 
-	return instances, nil
+	return append(instances, replacements...), nil
 }
 
 // TemplateNodeInfo returns a schedulercache.NodeInfo structure of an
@@ -498,28 +534,15 @@ func (ng *nodegroup) Autoprovisioned() bool {
 // NodeGroup. Returning a nil will result in using default options.
 func (ng *nodegroup) GetOptions(defaults config.NodeGroupAutoscalingOptions) (*config.NodeGroupAutoscalingOptions, error) {
 	options := ng.scalableResource.autoscalingOptions
-	if options == nil || len(options) == 0 {
+	if len(options) == 0 {
 		return &defaults, nil
 	}
 
-	if opt, ok := getFloat64Option(options, ng.Id(), config.DefaultScaleDownUtilizationThresholdKey); ok {
-		defaults.ScaleDownUtilizationThreshold = opt
-	}
-	if opt, ok := getFloat64Option(options, ng.Id(), config.DefaultScaleDownGpuUtilizationThresholdKey); ok {
-		defaults.ScaleDownGpuUtilizationThreshold = opt
-	}
-	if opt, ok := getDurationOption(options, ng.Id(), config.DefaultScaleDownUnneededTimeKey); ok {
-		defaults.ScaleDownUnneededTime = opt
-	}
-	if opt, ok := getDurationOption(options, ng.Id(), config.DefaultScaleDownUnreadyTimeKey); ok {
-		defaults.ScaleDownUnreadyTime = opt
-	}
-	if opt, ok := getDurationOption(options, ng.Id(), config.DefaultMaxNodeProvisionTimeKey); ok {
-		defaults.MaxNodeProvisionTime = opt
-	}
-	if opt, ok := getDurationOption(options, ng.Id(), config.DefaultMaxNodeStartupTimeKey); ok {
-		defaults.MaxNodeStartupTime = opt
-	}
+	// Invalid values are ignored so that the remaining options, and the
+	// defaults, still apply. They are reported as events on the scalable
+	// resource so that they are visible to the owners of the node group.
+	errs := applyAutoscalingOptions(&defaults, options)
+	ng.machineController.reportInvalidAutoscalingOptions(ng.Id(), ng.scalableResource.unstructured, errs)
 
 	return &defaults, nil
 }
@@ -663,33 +686,3 @@ func setLabelIfNotEmpty(to, from map[string]string, key string) {
 		to[key] = value
 	}
 }
-
-func getFloat64Option(options map[string]string, templateName, name string) (float64, bool) {
-	raw, ok := options[name]
-	if !ok {
-		return 0, false
-	}
-
-	option, err := strconv.ParseFloat(raw, 64)
-	if err != nil {
-		klog.Warningf("failed to convert autoscaling_options option %q (value %q) for scalable resource %q to float: %v", name, raw, templateName, err)
-		return 0, false
-	}
-
-	return option, true
-}
-
-func getDurationOption(options map[string]string, templateName, name string) (time.Duration, bool) {
-	raw, ok := options[name]
-	if !ok {
-		return 0, false
-	}
-
-	option, err := time.ParseDuration(raw)
-	if err != nil {
-		klog.Warningf("failed to convert autoscaling_options option %q (value %q) for scalable resource %q to duration: %v", name, raw, templateName, err)
-		return 0, false
-	}
-
-	return option, true
-}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
 	"sigs.k8s.io/cluster-autoscaler/pkg/config"
@@ -1903,9 +1904,10 @@ func TestNodeGroupGetOptions(t *testing.T) {
 	}
 
 	cases := []struct {
-		desc     string
-		opts     map[string]string
-		expected *config.NodeGroupAutoscalingOptions
+		desc           string
+		opts           map[string]string
+		expected       *config.NodeGroupAutoscalingOptions
+		expectedEvents int
 	}{
 		{
 			desc:     "return provided defaults on empty metadata",
@@ -1921,6 +1923,8 @@ func TestNodeGroupGetOptions(t *testing.T) {
 				config.DefaultScaleDownUnreadyTimeKey:             "30m",
 				config.DefaultMaxNodeProvisionTimeKey:             "60m",
 				config.DefaultMaxNodeStartupTimeKey:               "35m",
+				config.DefaultIgnoreDaemonSetsUtilizationKey:      "true",
+				zeroOrMaxNodeScalingOptionKey:                     "true",
 			},
 			expected: &config.NodeGroupAutoscalingOptions{
 				ScaleDownGpuUtilizationThreshold: 0.6,
@@ -1929,6 +1933,8 @@ func TestNodeGroupGetOptions(t *testing.T) {
 				ScaleDownUnreadyTime:             30 * time.Minute,
 				MaxNodeProvisionTime:             60 * time.Minute,
 				MaxNodeStartupTime:               35 * time.Minute,
+				IgnoreDaemonSetsUtilization:      true,
+				ZeroOrMaxNodeScaling:             true,
 			},
 		},
 		{
@@ -1952,11 +1958,39 @@ func TestNodeGroupGetOptions(t *testing.T) {
 				config.DefaultScaleDownGpuUtilizationThresholdKey: "foo",
 				config.DefaultScaleDownUnneededTimeKey:            "bar",
 			},
-			expected: &defaultOptions,
+			expected:       &defaultOptions,
+			expectedEvents: 2,
+		},
+		{
+			desc: "keep defaults on out of range and unknown options",
+			opts: map[string]string{
+				config.DefaultScaleDownUtilizationThresholdKey: "1.5",
+				config.DefaultScaleDownUnreadyTimeKey:          "-1m",
+				config.DefaultIgnoreDaemonSetsUtilizationKey:   "maybe",
+				"notanoption": "1",
+			},
+			expected:       &defaultOptions,
+			expectedEvents: 4,
+		},
+		{
+			desc: "apply valid options next to invalid ones",
+			opts: map[string]string{
+				config.DefaultScaleDownUtilizationThresholdKey: "0.3",
+				config.DefaultScaleDownUnneededTimeKey:         "bar",
+			},
+			expected: &config.NodeGroupAutoscalingOptions{
+				ScaleDownGpuUtilizationThreshold: defaultOptions.ScaleDownGpuUtilizationThreshold,
+				ScaleDownUtilizationThreshold:    0.3,
+				ScaleDownUnneededTime:            defaultOptions.ScaleDownUnneededTime,
+				ScaleDownUnreadyTime:             defaultOptions.ScaleDownUnreadyTime,
+				MaxNodeProvisionTime:             defaultOptions.MaxNodeProvisionTime,
+				MaxNodeStartupTime:               defaultOptions.MaxNodeStartupTime,
+			},
+			expectedEvents: 1,
 		},
 	}
 
-	test := func(t *testing.T, testConfig *TestConfig, expectedOptions *config.NodeGroupAutoscalingOptions) {
+	test := func(t *testing.T, testConfig *TestConfig, expectedOptions *config.NodeGroupAutoscalingOptions, expectedEvents int) {
 		controller := NewTestMachineController(t)
 		defer controller.Stop()
 		controller.AddTestConfigs(testConfig)
@@ -1974,6 +2008,18 @@ func TestNodeGroupGetOptions(t *testing.T) {
 		opts, err := ng.GetOptions(defaultOptions)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedOptions, opts)
+
+		recorder := controller.eventRecorder.(*record.FakeRecorder)
+		assert.Len(t, recorder.Events, expectedEvents)
+		for i := 0; i < expectedEvents; i++ {
+			assert.Contains(t, <-recorder.Events, invalidAutoscalingOptionEventReason)
+		}
+
+		// The same invalid options are only reported once.
+		opts, err = ng.GetOptions(defaultOptions)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedOptions, opts)
+		assert.Empty(t, recorder.Events)
 	}
 
 	for _, c := range cases {
@@ -1990,7 +2036,7 @@ func TestNodeGroupGetOptions(t *testing.T) {
 					WithNodeCount(10).
 					WithAnnotations(cloudprovider.JoinStringMaps(enableScaleAnnotations, annotations)).
 					Build()
-				test(t, testConfig, c.expected)
+				test(t, testConfig, c.expected, c.expectedEvents)
 			})
 
 			t.Run("MachineDeployment", func(t *testing.T) {
@@ -2000,7 +2046,17 @@ func TestNodeGroupGetOptions(t *testing.T) {
 					WithNodeCount(10).
 					WithAnnotations(cloudprovider.JoinStringMaps(enableScaleAnnotations, annotations)).
 					Build()
-				test(t, testConfig, c.expected)
+				test(t, testConfig, c.expected, c.expectedEvents)
+			})
+
+			t.Run("MachinePool", func(t *testing.T) {
+				testConfig := NewTestConfigBuilder().
+					ForMachinePool().
+					WithNamespace(testNamespace).
+					WithNodeCount(10).
+					WithAnnotations(cloudprovider.JoinStringMaps(enableScaleAnnotations, annotations)).
+					Build()
+				test(t, testConfig, c.expected, c.expectedEvents)
 			})
 		})
 	}
@@ -2015,6 +2071,7 @@ func TestNodeGroupNodesInstancesStatus(t *testing.T) {
 		includeFailedMachineWithNodeRef    bool
 		includeFailedMachineWithoutNodeRef bool
 		includeFailedMachineDeleting       bool
+		includeRemediatingMachine          bool
 	}
 
 	testCases := []testCase{
@@ -2042,6 +2099,11 @@ func TestNodeGroupNodesInstancesStatus(t *testing.T) {
 			nodeCount:                          5,
 			includeFailedMachineWithoutNodeRef: true,
 		},
+		{
+			description:               "includes a machine being remediated",
+			nodeCount:                 5,
+			includeRemediatingMachine: true,
+		},
 	}
 
 	test := func(t *testing.T, tc *testCase, testConfig *TestConfig) {
@@ -2121,6 +2183,30 @@ func TestNodeGroupNodesInstancesStatus(t *testing.T) {
 			}
 		}
 
+		var remediatingProviderID, remediatingReplacementID string
+		if tc.includeRemediatingMachine {
+			if tc.nodeCount < 1 {
+				t.Fatal("test cannot pass, remediating machine requires at least 1 machine in machineset")
+			}
+
+			machine := testConfig.machines[0].DeepCopy()
+			conditions := []interface{}{
+				map[string]interface{}{
+					"type":   machineOwnerRemediatedCondition,
+					"status": "False",
+				},
+			}
+			if err := unstructured.SetNestedSlice(machine.Object, conditions, "status", "conditions"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			remediatingProviderID = testConfig.nodes[0].Spec.ProviderID
+			remediatingReplacementID = createPendingMachineProviderID(machine.GetNamespace(), machine.GetName())
+
+			if err := controller.UpdateResource(controller.machineInformer, controller.machineResource, machine); err != nil {
+				t.Fatalf("unexpected error updating machine, got %v", err)
+			}
+		}
+
 		nodegroups, err := controller.nodeGroups()
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -2137,6 +2223,10 @@ func TestNodeGroupNodesInstancesStatus(t *testing.T) {
 		}
 
 		expectedCount := tc.nodeCount
+		if tc.includeRemediatingMachine {
+			// The replacement of the machine being remediated.
+			expectedCount++
+		}
 		if len(instances) != expectedCount {
 			t.Errorf("expected %d nodes, got %d", expectedCount, len(instances))
 		}
@@ -2148,7 +2238,11 @@ func TestNodeGroupNodesInstancesStatus(t *testing.T) {
 
 		for _, instance := range instances {
 			t.Logf("instance: %v", instance)
-			if tc.includePendingMachine && strings.HasPrefix(instance.Id, pendingMachinePrefix) {
+			if tc.includeRemediatingMachine && instance.Id == remediatingReplacementID {
+				if instance.Status == nil || instance.Status.State != cloudprovider.InstanceCreating {
+					t.Errorf("expected replacement of remediating machine to have status %v, got %v", cloudprovider.InstanceCreating, instance.Status)
+				}
+			} else if tc.includePendingMachine && strings.HasPrefix(instance.Id, pendingMachinePrefix) {
 				if instance.Status == nil || instance.Status.State != cloudprovider.InstanceCreating {
 					t.Errorf("expected pending machine to have status %v, got %v", cloudprovider.InstanceCreating, instance.Status)
 				}
@@ -2170,6 +2264,10 @@ func TestNodeGroupNodesInstancesStatus(t *testing.T) {
 				if instance.Status == nil || instance.Status.ErrorInfo.ErrorCode != "ProvisioningFailed" {
 					t.Errorf("expected failed machine without nodeRef to have error code %v, got %v", "ProvisioningFailed", instance.Status.ErrorInfo.ErrorCode)
 				}
+			} else if tc.includeRemediatingMachine && instance.Id == remediatingProviderID {
+				if instance.Status == nil || instance.Status.State != cloudprovider.InstanceDeleting {
+					t.Errorf("expected remediating machine to have status %v, got %v", cloudprovider.InstanceDeleting, instance.Status)
+				}
 			} else if tc.includeFailedMachineDeleting && strings.HasPrefix(instance.Id, failedMachinePrefix) {
 				if instance.Status == nil || instance.Status.State != cloudprovider.InstanceDeleting {
 					t.Errorf("expected failed machine deleting to have status %v, got %v", cloudprovider.InstanceDeleting, instance.Status)
@@ -24,15 +24,16 @@ import (
 )
 
 // ScaleDownNodeUpgradeProcessor is a processor to filter out
-// nodes that are undergoing an upgrade through a MachineDeployment.
+// nodes that are undergoing an upgrade through a MachineDeployment,
+// or that are being remediated by a MachineHealthCheck.
 type ScaleDownNodeUpgradeProcessor struct {
-	controller *machineController
+	controllers []*machineController
 }
 
 // NewScaleDownNodeUpgradeProcessor returns a new ScaleDownNodeUpgradeProcessor for use when
 // registering a new upgrade scale down processor.
-func NewScaleDownNodeUpgradeProcessor(c *machineController) *ScaleDownNodeUpgradeProcessor {
-	return &ScaleDownNodeUpgradeProcessor{controller: c}
+func NewScaleDownNodeUpgradeProcessor(controllers ...*machineController) *ScaleDownNodeUpgradeProcessor {
+	return &ScaleDownNodeUpgradeProcessor{controllers: controllers}
 }
 
 // GetPodDestinationCandidates returns nodes as is no processing is required here
@@ -48,7 +49,7 @@ func (p *ScaleDownNodeUpgradeProcessor) GetScaleDownCandidates(ctx *context.Auto
 
 	for _, node := range nodes {
 		// check scale down, continue if not good
-		ng, err := p.controller.nodeGroupForNode(node)
+		ng, err := findNodeGroupForNode(p.controllers, node)
 		if err != nil {
 			klog.Warningf("Error while checking node group for node %s: %v", node.Name, err)
 			continue
@@ -70,6 +71,17 @@ func (p *ScaleDownNodeUpgradeProcessor) GetScaleDownCandidates(ctx *context.Auto
 			klog.V(4).Infof("Node %s will be skipped as it is currently under rollout", node.Name)
 			continue
 		}
+
+		// MachineHealthCheck is already replacing the node, leave it to the remediation.
+		remediating, err := ng.machineController.isNodeBeingRemediated(node)
+		if err != nil {
+			klog.Warningf("Failed to determine remediation status for node %s: %v", node.Name, err)
+			continue
+		}
+		if remediating {
+			klog.V(4).Infof("Node %s will be skipped as it is currently being remediated", node.Name)
+			continue
+		}
 		result = append(result, node)
 	}
 	return result, nil
@@ -19,16 +19,25 @@ package clusterapi
 import (
 	"fmt"
 	"path"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	resourcelisters "k8s.io/client-go/listers/resource/v1"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/scale"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 	klog "k8s.io/klog/v2"
 
 	"k8s.io/client-go/informers"
@@ -45,7 +54,7 @@ const ProviderName = "clusterapi"
 
 func init() {
 	builder.RegisterCloudProvider(ProviderName, func(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDiscoveryOptions, rl *cloudprovider.ResourceLimiter, informerFactory informers.SharedInformerFactory) cloudprovider.CloudProvider {
-		return BuildClusterAPI(opts, do, rl)
+		return BuildClusterAPI(opts, do, rl, informerFactory)
 	})
 	builder.SetDefaultCloudProvider(ProviderName)
 }
@@ -58,7 +67,8 @@ const (
 var _ cloudprovider.CloudProvider = (*provider)(nil)
 
 type provider struct {
-	controller      *machineController
+	// controllers holds one machine controller per management cluster.
+	controllers     []*machineController
 	providerName    string
 	resourceLimiter *cloudprovider.ResourceLimiter
 }
@@ -72,16 +82,22 @@ func (p *provider) GetResourceLimiter() (*cloudprovider.ResourceLimiter, error)
 }
 
 func (p *provider) NodeGroups() []cloudprovider.NodeGroup {
-	nodegroups, err := p.controller.nodeGroups()
-	if err != nil {
-		klog.Errorf("error getting node groups: %v", err)
-		return nil
+	var nodegroups []cloudprovider.NodeGroup
+	for _, controller := range p.controllers {
+		controllerNodeGroups, err := controller.nodeGroups()
+		if err != nil {
+			// One unreachable management cluster must not stop autoscaling
+			// the node groups of the others.
+			klog.Errorf("error getting node groups of management cluster %q: %v", controller.managementCluster, err)
+			continue
+		}
+		nodegroups = append(nodegroups, controllerNodeGroups...)
 	}
 	return nodegroups
 }
 
 func (p *provider) NodeGroupForNode(node *corev1.Node) (cloudprovider.NodeGroup, error) {
-	ng, err := p.controller.nodeGroupForNode(node)
+	ng, err := findNodeGroupForNode(p.controllers, node)
 	if err != nil {
 		return nil, err
 	}
@@ -91,17 +107,47 @@ func (p *provider) NodeGroupForNode(node *corev1.Node) (cloudprovider.NodeGroup,
 	return ng, nil
 }
 
+// findNodeGroupForNode returns the node group of the node from the first
+// management cluster which has one. Normalized provider IDs of machines
+// without a node carry their management cluster and are only looked up there.
+// Failing to look the node up in one management cluster is only an error when
+// no other management cluster has it.
+func findNodeGroupForNode(controllers []*machineController, node *corev1.Node) (*nodegroup, error) {
+	managementCluster := managementClusterFromProviderID(normalizedProviderString(node.Spec.ProviderID))
+	var errs []error
+	for _, controller := range controllers {
+		if managementCluster != "" && controller.managementCluster != managementCluster {
+			continue
+		}
+		ng, err := controller.nodeGroupForNode(node)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if ng != nil {
+			return ng, nil
+		}
+	}
+	return nil, utilerrors.NewAggregate(errs)
+}
+
 // HasInstance returns whether a given node has a corresponding instance in this cloud provider
 func (p *provider) HasInstance(node *corev1.Node) (bool, error) {
 	machineID := node.Annotations[machineAnnotationKey]
 	ns := node.Annotations[clusterNamespaceAnnotationKey]
 
-	machine, err := p.controller.findMachine(path.Join(ns, machineID))
-	if machine != nil {
-		return true, nil
+	var errs []error
+	for _, controller := range p.controllers {
+		machine, err := controller.findMachine(path.Join(ns, machineID))
+		if machine != nil {
+			return true, nil
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
 	}
 
-	return false, fmt.Errorf("machine not found for node %s: %v", node.Name, err)
+	return false, fmt.Errorf("machine not found for node %s: %v", node.Name, utilerrors.NewAggregate(errs))
 }
 
 func (*provider) Pricing() (cloudprovider.PricingModel, errors.AutoscalerError) {
@@ -155,28 +201,77 @@ func (p *provider) GetNodeGpuConfig(node *corev1.Node) *cloudprovider.GpuConfig
 func newProvider(
 	name string,
 	rl *cloudprovider.ResourceLimiter,
-	controller *machineController,
+	controllers ...*machineController,
 ) cloudprovider.CloudProvider {
 	return &provider{
 		providerName:    name,
 		resourceLimiter: rl,
-		controller:      controller,
+		controllers:     controllers,
 	}
 }
 
+// managementClusterConfig is a management cluster the autoscaler discovers
+// node groups in.
+type managementClusterConfig struct {
+	// name identifies the management cluster when there are several of
+	// them, it is empty when there is a single management cluster.
+	name string
+	// kubeconfig is the path of the kubeconfig of the management cluster.
+	kubeconfig string
+}
+
+// parseManagementClusters parses the cloud config flag. The flag is either
+// the path of a single kubeconfig, or a comma separated list of name=path
+// pairs, one per management cluster.
+func parseManagementClusters(cloudConfig string) ([]managementClusterConfig, error) {
+	entries := strings.Split(cloudConfig, ",")
+	if len(entries) == 1 && !strings.Contains(cloudConfig, "=") {
+		return []managementClusterConfig{{kubeconfig: cloudConfig}}, nil
+	}
+
+	clusters := make([]managementClusterConfig, 0, len(entries))
+	names := sets.New[string]()
+	for _, entry := range entries {
+		name, kubeconfig, found := strings.Cut(entry, "=")
+		if !found || kubeconfig == "" {
+			return nil, fmt.Errorf("management cluster %q should be name=kubeconfig", entry)
+		}
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid management cluster name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if names.Has(name) {
+			return nil, fmt.Errorf("duplicate management cluster name %q", name)
+		}
+		names.Insert(name)
+		clusters = append(clusters, managementClusterConfig{name: name, kubeconfig: kubeconfig})
+	}
+	return clusters, nil
+}
+
 // BuildClusterAPI builds CloudProvider implementation for machine api.
-func BuildClusterAPI(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDiscoveryOptions, rl *cloudprovider.ResourceLimiter) cloudprovider.CloudProvider {
+func BuildClusterAPI(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDiscoveryOptions, rl *cloudprovider.ResourceLimiter, informerFactory informers.SharedInformerFactory) cloudprovider.CloudProvider {
 	managementKubeconfig := opts.CloudConfig
 	if managementKubeconfig == "" && !opts.ClusterAPICloudConfigAuthoritative {
 		managementKubeconfig = opts.KubeClientOpts.KubeConfigPath
 	}
 
-	managementConfig, err := clientcmd.BuildConfigFromFlags("", managementKubeconfig)
+	managementClusters, err := parseManagementClusters(managementKubeconfig)
 	if err != nil {
-		klog.Fatalf("cannot build management cluster config: %v", err)
+		klog.Fatalf("cannot parse management clusters: %v", err)
+	}
+
+	autoDiscoverySpecs, err := parseAutoDiscovery(do.NodeGroupAutoDiscoverySpecs)
+	if err != nil {
+		klog.Fatalf("failed to parse auto discovery configuration: %v", err)
+	}
+	for _, spec := range autoDiscoverySpecs {
+		if spec.managementCluster == "" {
+			continue
+		}
+		if !slices.ContainsFunc(managementClusters, func(c managementClusterConfig) bool { return c.name == spec.managementCluster }) {
+			klog.Fatalf("auto discovery configuration refers to unknown management cluster %q", spec.managementCluster)
+		}
 	}
-	managementConfig.QPS = opts.KubeClientOpts.KubeClientQPS
-	managementConfig.Burst = opts.KubeClientOpts.KubeClientBurst
 
 	workloadKubeconfig := opts.KubeClientOpts.KubeConfigPath
 
@@ -187,20 +282,64 @@ func BuildClusterAPI(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeG
 	workloadConfig.QPS = opts.KubeClientOpts.KubeClientQPS
 	workloadConfig.Burst = opts.KubeClientOpts.KubeClientBurst
 
-	// Grab a dynamic interface that we can create informers from
-	managementClient, err := dynamic.NewForConfig(managementConfig)
+	workloadClient, err := kubernetes.NewForConfig(workloadConfig)
 	if err != nil {
-		klog.Fatalf("could not generate dynamic client for config: %v", err)
+		klog.Fatalf("create kube clientset failed: %v", err)
 	}
 
-	workloadClient, err := kubernetes.NewForConfig(workloadConfig)
+	// Device classes come from the informer factory of the autoscaler, which
+	// already watches them for DRA scheduling.
+	var deviceClassLister resourcelisters.DeviceClassLister
+	if opts.DynamicResourceAllocationEnabled && informerFactory != nil {
+		deviceClassLister = informerFactory.Resource().V1().DeviceClasses().Lister()
+	}
+
+	controllers := make([]*machineController, 0, len(managementClusters))
+	for _, managementCluster := range managementClusters {
+		controller, err := buildMachineController(opts, do, managementCluster, workloadClient)
+		if err != nil {
+			if len(managementClusters) == 1 {
+				klog.Fatal(err)
+			}
+			// One unreachable management cluster must not stop autoscaling
+			// the node groups of the others.
+			klog.Errorf("skipping management cluster %q: %v", managementCluster.name, err)
+			continue
+		}
+		controller.deviceClassLister = deviceClassLister
+		controllers = append(controllers, controller)
+	}
+	if len(controllers) == 0 {
+		klog.Fatalf("none of the %d management clusters can be watched", len(managementClusters))
+	}
+
+	scaleDownUpgradeProcessor := NewScaleDownNodeUpgradeProcessor(controllers...)
+	if err := scaledowncandidates.RegisterCombinedScaleDownCandidateProcessor(opts.Processors.ScaleDownNodeProcessor, scaleDownUpgradeProcessor); err != nil {
+		klog.Fatalf("unable to register scale down upgrade processor: %v", err)
+	}
+
+	return newProvider(cloudprovider.ClusterAPIProviderName, rl, controllers...)
+}
+
+// buildMachineController builds and runs the machine controller watching a
+// single management cluster.
+func buildMachineController(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDiscoveryOptions, managementCluster managementClusterConfig, workloadClient kubernetes.Interface) (*machineController, error) {
+	managementConfig, err := clientcmd.BuildConfigFromFlags("", managementCluster.kubeconfig)
 	if err != nil {
-		klog.Fatalf("create kube clientset failed: %v", err)
+		return nil, fmt.Errorf("cannot build management cluster config: %v", err)
+	}
+	managementConfig.QPS = opts.KubeClientOpts.KubeClientQPS
+	managementConfig.Burst = opts.KubeClientOpts.KubeClientBurst
+
+	// Grab a dynamic interface that we can create informers from
+	managementClient, err := dynamic.NewForConfig(managementConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate dynamic client for config: %v", err)
 	}
 
 	managementDiscoveryClient, err := discovery.NewDiscoveryClientForConfig(managementConfig)
 	if err != nil {
-		klog.Fatalf("create discovery client failed: %v", err)
+		return nil, fmt.Errorf("create discovery client failed: %v", err)
 	}
 
 	cachedDiscovery := memory.NewMemCacheClient(managementDiscoveryClient)
@@ -210,26 +349,33 @@ func BuildClusterAPI(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeG
 		dynamic.LegacyAPIPathResolverFunc,
 		scale.NewDiscoveryScaleKindResolver(managementDiscoveryClient))
 	if err != nil {
-		klog.Fatalf("create scale client failed: %v", err)
+		return nil, fmt.Errorf("create scale client failed: %v", err)
+	}
+
+	// Events about the scalable resources, such as invalid autoscaling options,
+	// are recorded in the management cluster next to the resources.
+	managementKubeClient, err := kubernetes.NewForConfig(managementConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create management kube clientset failed: %v", err)
 	}
 
 	// Ideally this would be passed in but the builder is not
 	// currently organised to do so.
 	stopCh := make(chan struct{})
 
-	controller, err := newMachineController(managementClient, workloadClient, managementDiscoveryClient, managementScaleClient, do, stopCh)
+	controller, err := newMachineController(managementCluster.name, managementClient, workloadClient, managementDiscoveryClient, managementScaleClient, do, stopCh)
 	if err != nil {
-		klog.Fatal(err)
-	}
-
-	scaleDownUpgradeProcessor := NewScaleDownNodeUpgradeProcessor(controller)
-	if err := scaledowncandidates.RegisterCombinedScaleDownCandidateProcessor(opts.Processors.ScaleDownNodeProcessor, scaleDownUpgradeProcessor); err != nil {
-		klog.Fatalf("unable to register scale down upgrade processor: %v", err)
+		return nil, err
 	}
-
 	if err := controller.run(); err != nil {
-		klog.Fatal(err)
+		// Stop the informers of the management cluster being skipped.
+		close(stopCh)
+		return nil, err
 	}
 
-	return newProvider(cloudprovider.ClusterAPIProviderName, rl, controller)
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: managementKubeClient.CoreV1().Events("")})
+	controller.eventRecorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "cluster-autoscaler"})
+
+	return controller, nil
 }
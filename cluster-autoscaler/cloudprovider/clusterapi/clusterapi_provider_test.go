@@ -19,10 +19,12 @@ package clusterapi
 import (
 	"reflect"
 	"slices"
+	"strings"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
 )
@@ -273,3 +275,257 @@ func TestNodeGroups(t *testing.T) {
 		controller.Stop()
 	}
 }
+
+func TestProviderMultipleManagementClusters(t *testing.T) {
+	resourceLimits := cloudprovider.ResourceLimiter{}
+	annotations := map[string]string{
+		nodeGroupMinSizeAnnotationKey: "1",
+		nodeGroupMaxSizeAnnotationKey: "10",
+	}
+
+	blue := NewTestMachineController(t)
+	defer blue.Stop()
+	blue.managementCluster = "blue"
+	blueConfig := NewTestConfigBuilder().
+		ForMachineDeployment().
+		WithNodeCount(2).
+		WithAnnotations(annotations).
+		Build()
+	if err := blue.AddTestConfigs(blueConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	green := NewTestMachineController(t)
+	defer green.Stop()
+	green.managementCluster = "green"
+	greenConfig := NewTestConfigBuilder().
+		ForMachineDeployment().
+		WithNodeCount(1).
+		WithAnnotations(annotations).
+		Build()
+	if err := green.AddTestConfigs(greenConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	provider := newProvider(cloudprovider.ClusterAPIProviderName, &resourceLimits, blue.machineController, green.machineController)
+
+	nodegroups := provider.NodeGroups()
+	if len(nodegroups) != 2 {
+		t.Fatalf("expected 2 node groups, got %d", len(nodegroups))
+	}
+
+	ng, err := provider.NodeGroupForNode(greenConfig.nodes[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ng == nil {
+		t.Fatal("expected a node group")
+	}
+	if expected := "green/" + greenConfig.machineDeployment.GetKind() + "/" + greenConfig.machineDeployment.GetNamespace() + "/" + greenConfig.machineDeployment.GetName(); ng.Id() != expected {
+		t.Errorf("expected node group %q, got %q", expected, ng.Id())
+	}
+
+	// A machine without a node gets a normalized provider ID which carries
+	// its management cluster.
+	machine := blueConfig.machines[0].DeepCopy()
+	unstructured.RemoveNestedField(machine.Object, "spec", "providerID")
+	unstructured.RemoveNestedField(machine.Object, "status", "nodeRef")
+	if err := blue.UpdateResource(blue.machineInformer, blue.machineResource, machine); err != nil {
+		t.Fatalf("unexpected error updating machine, got %v", err)
+	}
+
+	pendingProviderID := createPendingMachineProviderID("blue@"+machine.GetNamespace(), machine.GetName())
+	ng, err = provider.NodeGroupForNode(&corev1.Node{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "pending-node",
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: pendingProviderID,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ng == nil {
+		t.Fatal("expected a node group for the pending machine")
+	}
+	if !strings.HasPrefix(ng.Id(), "blue/") {
+		t.Errorf("expected node group from management cluster blue, got %q", ng.Id())
+	}
+
+	instances, err := ng.Nodes()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.ContainsFunc(instances, func(i cloudprovider.Instance) bool { return i.Id == pendingProviderID }) {
+		t.Errorf("expected instances to contain %q, got %v", pendingProviderID, instances)
+	}
+}
+
+func TestProviderNodeGroupsSkipsFailingManagementCluster(t *testing.T) {
+	resourceLimits := cloudprovider.ResourceLimiter{}
+
+	blue := NewTestMachineController(t)
+	defer blue.Stop()
+	blue.managementCluster = "blue"
+	blueConfig := NewTestConfigBuilder().
+		ForMachineDeployment().
+		WithNodeCount(1).
+		WithAnnotations(map[string]string{
+			nodeGroupMinSizeAnnotationKey: "1",
+			nodeGroupMaxSizeAnnotationKey: "10",
+		}).
+		Build()
+	if err := blue.AddTestConfigs(blueConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The node groups of the red management cluster fail to build.
+	red := NewTestMachineController(t)
+	defer red.Stop()
+	red.managementCluster = "red"
+	redConfig := NewTestConfigBuilder().
+		ForMachineDeployment().
+		WithNodeCount(1).
+		WithAnnotations(map[string]string{
+			nodeGroupMinSizeAnnotationKey: "1",
+			nodeGroupMaxSizeAnnotationKey: "not-a-number",
+		}).
+		Build()
+	if err := red.AddTestConfigs(redConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := red.nodeGroups(); err == nil {
+		t.Fatal("expected an error getting the node groups of the red management cluster")
+	}
+
+	provider := newProvider(cloudprovider.ClusterAPIProviderName, &resourceLimits, red.machineController, blue.machineController)
+
+	nodegroups := provider.NodeGroups()
+	if len(nodegroups) != 1 {
+		t.Fatalf("expected 1 node group, got %d", len(nodegroups))
+	}
+	if !strings.HasPrefix(nodegroups[0].Id(), "blue/") {
+		t.Errorf("expected node group from management cluster blue, got %q", nodegroups[0].Id())
+	}
+}
+
+func TestProviderNodeGroupForNodeSkipsFailingManagementCluster(t *testing.T) {
+	resourceLimits := cloudprovider.ResourceLimiter{}
+
+	blue := NewTestMachineController(t)
+	defer blue.Stop()
+	blue.managementCluster = "blue"
+	blueConfig := NewTestConfigBuilder().
+		ForMachineDeployment().
+		WithNodeCount(1).
+		WithAnnotations(map[string]string{
+			nodeGroupMinSizeAnnotationKey: "1",
+			nodeGroupMaxSizeAnnotationKey: "10",
+		}).
+		Build()
+	if err := blue.AddTestConfigs(blueConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	node := blueConfig.nodes[0]
+
+	// The red management cluster has a machine with the same provider ID,
+	// whose node group fails to build.
+	red := NewTestMachineController(t)
+	defer red.Stop()
+	red.managementCluster = "red"
+	redConfig := NewTestConfigBuilder().
+		ForMachineDeployment().
+		WithNodeCount(1).
+		WithAnnotations(map[string]string{
+			nodeGroupMinSizeAnnotationKey: "1",
+			nodeGroupMaxSizeAnnotationKey: "not-a-number",
+		}).
+		Build()
+	if err := red.AddTestConfigs(redConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	machine := redConfig.machines[0].DeepCopy()
+	if err := unstructured.SetNestedField(machine.Object, node.Spec.ProviderID, "spec", "providerID"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := red.UpdateResource(red.machineInformer, red.machineResource, machine); err != nil {
+		t.Fatalf("unexpected error updating machine, got %v", err)
+	}
+
+	provider := newProvider(cloudprovider.ClusterAPIProviderName, &resourceLimits, red.machineController)
+	if _, err := provider.NodeGroupForNode(node); err == nil {
+		t.Fatal("expected an error when no management cluster has the node")
+	}
+
+	provider = newProvider(cloudprovider.ClusterAPIProviderName, &resourceLimits, red.machineController, blue.machineController)
+	ng, err := provider.NodeGroupForNode(node)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ng == nil {
+		t.Fatal("expected a node group")
+	}
+	if !strings.HasPrefix(ng.Id(), "blue/") {
+		t.Errorf("expected node group from management cluster blue, got %q", ng.Id())
+	}
+}
+
+func TestParseManagementClusters(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		cloudConfig string
+		expected    []managementClusterConfig
+		expectErr   bool
+	}{
+		{
+			name:        "in-cluster configuration",
+			cloudConfig: "",
+			expected:    []managementClusterConfig{{}},
+		},
+		{
+			name:        "single kubeconfig",
+			cloudConfig: "/mnt/kubeconfig",
+			expected:    []managementClusterConfig{{kubeconfig: "/mnt/kubeconfig"}},
+		},
+		{
+			name:        "named management clusters",
+			cloudConfig: "blue=/mnt/blue.kubeconfig,green=/mnt/green.kubeconfig",
+			expected: []managementClusterConfig{
+				{name: "blue", kubeconfig: "/mnt/blue.kubeconfig"},
+				{name: "green", kubeconfig: "/mnt/green.kubeconfig"},
+			},
+		},
+		{
+			name:        "unnamed management cluster in list",
+			cloudConfig: "blue=/mnt/blue.kubeconfig,/mnt/green.kubeconfig",
+			expectErr:   true,
+		},
+		{
+			name:        "duplicate management cluster name",
+			cloudConfig: "blue=/mnt/blue.kubeconfig,blue=/mnt/green.kubeconfig",
+			expectErr:   true,
+		},
+		{
+			name:        "invalid management cluster name",
+			cloudConfig: "Blue_1=/mnt/blue.kubeconfig",
+			expectErr:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			clusters, err := parseManagementClusters(tc.cloudConfig)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(clusters, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, clusters)
+			}
+		})
+	}
+}
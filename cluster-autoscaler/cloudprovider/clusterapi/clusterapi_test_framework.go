@@ -41,6 +41,7 @@ import (
 	fakekube "k8s.io/client-go/kubernetes/fake"
 	fakescale "k8s.io/client-go/scale/fake"
 	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	klog "k8s.io/klog/v2"
 	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
 )
@@ -640,6 +641,14 @@ type testMachineController struct {
 // NewTestMachineController returns a new machineController wrapped by a test harness and associated with a testing interface.
 func NewTestMachineController(t testing.TB) *testMachineController {
 	t.Helper()
+	return newTestMachineControllerWithReactors(t)
+}
+
+// newTestMachineControllerWithReactors returns a test machineController whose
+// management cluster client runs the reactors before the default ones, e.g. to
+// fail requests for some resources.
+func newTestMachineControllerWithReactors(t testing.TB, reactors ...clientgotesting.ReactionFunc) *testMachineController {
+	t.Helper()
 
 	kubeclientSet := fakekube.NewSimpleClientset()
 	dynamicClientset := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(
@@ -652,6 +661,7 @@ func NewTestMachineController(t testing.TB) *testMachineController {
 			{Group: "cluster.x-k8s.io", Version: "v1beta2", Resource: "machines"}:                        "kindList",
 			{Group: "cluster.x-k8s.io", Version: "v1beta2", Resource: "machinesets"}:                     "kindList",
 			{Group: "cluster.x-k8s.io", Version: "v1beta2", Resource: "machinepools"}:                    "kindList",
+			{Group: "cluster.x-k8s.io", Version: "v1beta2", Resource: "machinehealthchecks"}:             "kindList",
 			{Group: "custom.x-k8s.io", Version: "v1beta1", Resource: "machinepools"}:                     "kindList",
 			{Group: "custom.x-k8s.io", Version: "v1beta1", Resource: "machinedeployments"}:               "kindList",
 			{Group: "custom.x-k8s.io", Version: "v1beta1", Resource: "machines"}:                         "kindList",
@@ -659,6 +669,9 @@ func NewTestMachineController(t testing.TB) *testMachineController {
 			{Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "machinetemplates"}: "kindList",
 		},
 	)
+	for _, reactor := range reactors {
+		dynamicClientset.PrependReactor("*", "*", reactor)
+	}
 	discoveryClient := &fakediscovery.FakeDiscovery{
 		Fake: &clientgotesting.Fake{
 			Resources: []*metav1.APIResourceList{
@@ -703,6 +716,9 @@ func NewTestMachineController(t testing.TB) *testMachineController {
 						{
 							Name: resourceNameMachinePool,
 						},
+						{
+							Name: resourceNameMachineHealthCheck,
+						},
 					},
 				},
 			},
@@ -831,10 +847,11 @@ func NewTestMachineController(t testing.TB) *testMachineController {
 	scaleClient.AddReactor("*", "*", scaleReactor)
 
 	stopCh := make(chan struct{})
-	controller, err := newMachineController(dynamicClientset, kubeclientSet, discoveryClient, scaleClient, cloudprovider.NodeGroupDiscoveryOptions{}, stopCh)
+	controller, err := newMachineController("", dynamicClientset, kubeclientSet, discoveryClient, scaleClient, cloudprovider.NodeGroupDiscoveryOptions{}, stopCh)
 	if err != nil {
 		t.Fatal("failed to create test controller")
 	}
+	controller.eventRecorder = record.NewFakeRecorder(100)
 
 	if err := controller.run(); err != nil {
 		t.Fatalf("failed to run controller: %v", err)
@@ -20,7 +20,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -36,6 +38,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
+	resourcelisters "k8s.io/client-go/listers/resource/v1"
+	dracel "k8s.io/dynamic-resource-allocation/cel"
 	klog "k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
@@ -384,11 +388,30 @@ func (r *unstructuredScalableResource) InstanceSystemInfo() *corev1.NodeSystemIn
 	return &nsiObj
 }
 
+// InstanceResourceSlices returns the ResourceSlices that a new node in this
+// node group is expected to publish. The dra-driver and gpu-count annotations
+// take precedence, otherwise the devices published in the status.devices field
+// of the infrastructure reference resource are used.
 func (r *unstructuredScalableResource) InstanceResourceSlices(nodeName string) ([]*resourceapi.ResourceSlice, error) {
 	var result []*resourceapi.ResourceSlice
 	driver := r.InstanceDRADriver()
 	if driver == "" {
-		return nil, nil
+		infraObj, err := r.readInfrastructureReferenceResource()
+		if err != nil {
+			klog.Warningf("Unable to read the DRA devices of %s %s from its infrastructure reference: %v", r.Kind(), r.Name(), err)
+			return nil, nil
+		}
+		if infraObj == nil {
+			return nil, nil
+		}
+		devices, err := draDevicesFromInfrastructureObject(infraObj)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkDRADeviceClasses(r.controller.deviceClassLister, devices); err != nil {
+			return nil, fmt.Errorf("invalid status.devices of %s %s: %w", infraObj.GetKind(), infraObj.GetName(), err)
+		}
+		return resourceSlicesFromDRADevices(nodeName, devices), nil
 	}
 	gpuCount, err := r.InstanceGPUCapacityAnnotation()
 	if err != nil {
@@ -575,6 +598,257 @@ func resourceCapacityFromInfrastructureObject(infraobj *unstructured.Unstructure
 	return capacity
 }
 
+// draDeviceGroup describes a set of identical DRA devices that nodes created
+// from an infrastructure template are expected to publish.
+type draDeviceGroup struct {
+	// Driver is the name of the DRA driver publishing the devices.
+	Driver string
+	// Name is used as a prefix of the device names, it defaults to the device
+	// type turned into a DNS label or "device" when no type attribute is given.
+	Name string
+	// Count is the number of devices in the group.
+	Count int64
+	// DeviceClassName is the DRA device class the devices are expected to
+	// match, it is optional.
+	DeviceClassName string
+	// Attributes are copied to every device of the group.
+	Attributes map[resourceapi.QualifiedName]resourceapi.DeviceAttribute
+	// Capacity is copied to every device of the group.
+	Capacity map[resourceapi.QualifiedName]resourceapi.DeviceCapacity
+}
+
+// draDevicesFromInfrastructureObject reads the device groups from the
+// status.devices field of the infrastructure reference resource. Each entry
+// has the form:
+//
+//	driver: gpu.example.com
+//	deviceClassName: gpu.example.com
+//	name: gpu
+//	count: 2
+//	attributes:
+//	  type: gpu
+//	  model: a100
+//	capacity:
+//	  memory: 80Gi
+//
+// A node has at most maxDRADevicesPerNode devices over all the groups.
+func draDevicesFromInfrastructureObject(infraobj *unstructured.Unstructured) ([]draDeviceGroup, error) {
+	rawDevices, found, err := unstructured.NestedSlice(infraobj.Object, "status", "devices")
+	if !found || err != nil {
+		return nil, nil
+	}
+
+	var groups []draDeviceGroup
+	var total int64
+	for i, item := range rawDevices {
+		deviceMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid status.devices[%d] in %s %s: expected an object", i, infraobj.GetKind(), infraobj.GetName())
+		}
+		group := draDeviceGroup{}
+		if group.Driver, _, _ = unstructured.NestedString(deviceMap, "driver"); group.Driver == "" {
+			return nil, fmt.Errorf("missing driver in status.devices[%d] of %s %s", i, infraobj.GetKind(), infraobj.GetName())
+		}
+		if group.Count, _, err = unstructured.NestedInt64(deviceMap, "count"); err != nil || group.Count < 0 {
+			return nil, fmt.Errorf("invalid count in status.devices[%d] of %s %s", i, infraobj.GetKind(), infraobj.GetName())
+		}
+		if group.Count == 0 {
+			continue
+		}
+		if total += group.Count; total > maxDRADevicesPerNode {
+			return nil, fmt.Errorf("too many devices in status.devices of %s %s: at most %d are supported", infraobj.GetKind(), infraobj.GetName(), maxDRADevicesPerNode)
+		}
+		group.DeviceClassName, _, _ = unstructured.NestedString(deviceMap, "deviceClassName")
+
+		attributes, found, err := unstructured.NestedMap(deviceMap, "attributes")
+		if err != nil {
+			return nil, fmt.Errorf("invalid attributes in status.devices[%d] of %s %s: %w", i, infraobj.GetKind(), infraobj.GetName(), err)
+		}
+		if found {
+			group.Attributes = map[resourceapi.QualifiedName]resourceapi.DeviceAttribute{}
+			for k, v := range attributes {
+				attribute, err := deviceAttributeFromValue(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid attribute %q in status.devices[%d] of %s %s: %w", k, i, infraobj.GetKind(), infraobj.GetName(), err)
+				}
+				group.Attributes[resourceapi.QualifiedName(k)] = attribute
+			}
+		}
+
+		capacity, found, err := unstructured.NestedStringMap(deviceMap, "capacity")
+		if err != nil {
+			return nil, fmt.Errorf("invalid capacity in status.devices[%d] of %s %s: %w", i, infraobj.GetKind(), infraobj.GetName(), err)
+		}
+		if found {
+			group.Capacity = map[resourceapi.QualifiedName]resourceapi.DeviceCapacity{}
+			for k, v := range capacity {
+				value, err := resource.ParseQuantity(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid capacity %q in status.devices[%d] of %s %s: %w", k, i, infraobj.GetKind(), infraobj.GetName(), err)
+				}
+				group.Capacity[resourceapi.QualifiedName(k)] = resourceapi.DeviceCapacity{Value: value}
+			}
+		}
+
+		group.Name, _, _ = unstructured.NestedString(deviceMap, "name")
+		if group.Name != "" {
+			if errs := validation.IsDNS1123Label(group.Name); len(errs) > 0 || len(group.Name) > maxDRADeviceNamePrefixLength {
+				return nil, fmt.Errorf("invalid name %q in status.devices[%d] of %s %s: must be a DNS label of at most %d characters", group.Name, i, infraobj.GetKind(), infraobj.GetName(), maxDRADeviceNamePrefixLength)
+			}
+		} else {
+			group.Name = "device"
+			if deviceType, ok := group.Attributes["type"]; ok && deviceType.StringValue != nil {
+				if name := draDeviceNamePrefix(*deviceType.StringValue); name != "" {
+					group.Name = name
+				}
+			}
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// maxDRADevicesPerNode bounds the devices of a template node, spread over
+// several ResourceSlices of at most resourceapi.ResourceSliceMaxDevices each.
+const maxDRADevicesPerNode = 8 * resourceapi.ResourceSliceMaxDevices
+
+// maxDRADeviceNamePrefixLength leaves room for the index appended to the
+// prefix in the device names, which are DNS labels of at most 63 characters.
+const maxDRADeviceNamePrefixLength = 56
+
+// draDeviceNamePrefix turns a device type into a DNS label usable as the prefix
+// of device names, e.g. "NVIDIA A100" into "nvidia-a100". It returns an empty
+// string if nothing of the type can be used.
+func draDeviceNamePrefix(deviceType string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(deviceType) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		} else if b.Len() > 0 && !strings.HasSuffix(b.String(), "-") {
+			b.WriteRune('-')
+		}
+	}
+	name := b.String()
+	if len(name) > maxDRADeviceNamePrefixLength {
+		name = name[:maxDRADeviceNamePrefixLength]
+	}
+	return strings.Trim(name, "-")
+}
+
+func deviceAttributeFromValue(v interface{}) (resourceapi.DeviceAttribute, error) {
+	switch value := v.(type) {
+	case string:
+		return resourceapi.DeviceAttribute{StringValue: ptr.To(value)}, nil
+	case bool:
+		return resourceapi.DeviceAttribute{BoolValue: ptr.To(value)}, nil
+	case int64:
+		return resourceapi.DeviceAttribute{IntValue: ptr.To(value)}, nil
+	case float64:
+		if value != float64(int64(value)) {
+			return resourceapi.DeviceAttribute{}, fmt.Errorf("%v is not an integer", value)
+		}
+		return resourceapi.DeviceAttribute{IntValue: ptr.To(int64(value))}, nil
+	default:
+		return resourceapi.DeviceAttribute{}, fmt.Errorf("unsupported attribute type %T", v)
+	}
+}
+
+// resourceSlicesFromDRADevices builds the ResourceSlices of the template node,
+// one pool per driver in the order in which the drivers first appear. A pool is
+// split over several slices when it has more than
+// resourceapi.ResourceSliceMaxDevices devices.
+func resourceSlicesFromDRADevices(nodeName string, groups []draDeviceGroup) []*resourceapi.ResourceSlice {
+	var drivers []string
+	devices := map[string][]resourceapi.Device{}
+	deviceIndexes := map[string]int{}
+
+	for _, group := range groups {
+		if _, found := devices[group.Driver]; !found {
+			drivers = append(drivers, group.Driver)
+		}
+		for i := int64(0); i < group.Count; i++ {
+			// device names must be unique within a pool, even when groups share a name
+			key := group.Driver + "/" + group.Name
+			device := resourceapi.Device{
+				Name:       group.Name + "-" + strconv.Itoa(deviceIndexes[key]),
+				Attributes: maps.Clone(group.Attributes),
+				Capacity:   maps.Clone(group.Capacity),
+			}
+			deviceIndexes[key]++
+			devices[group.Driver] = append(devices[group.Driver], device)
+		}
+	}
+
+	var result []*resourceapi.ResourceSlice
+	for _, driver := range drivers {
+		chunks := slices.Collect(slices.Chunk(devices[driver], resourceapi.ResourceSliceMaxDevices))
+		for i, chunk := range chunks {
+			name := nodeName + "-" + driver
+			if i > 0 {
+				name += "-" + strconv.Itoa(i)
+			}
+			result = append(result, &resourceapi.ResourceSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: resourceapi.ResourceSliceSpec{
+					Driver:   driver,
+					NodeName: ptr.To(nodeName),
+					Pool: resourceapi.ResourcePool{
+						Name:               nodeName,
+						ResourceSliceCount: int64(len(chunks)),
+					},
+					Devices: chunk,
+				},
+			})
+		}
+	}
+
+	return result
+}
+
+// checkDRADeviceClasses returns an error if the devices of a group don't
+// match the selectors of the device class the group names, as a template
+// node whose devices can't be allocated for the class would make the
+// autoscaler scale up for claims that won't fit. The classes are not checked
+// when the lister is nil.
+func checkDRADeviceClasses(lister resourcelisters.DeviceClassLister, groups []draDeviceGroup) error {
+	if lister == nil {
+		return nil
+	}
+	for _, group := range groups {
+		if group.DeviceClassName == "" {
+			continue
+		}
+		class, err := lister.Get(group.DeviceClassName)
+		if err != nil {
+			return fmt.Errorf("cannot get device class %q of the %s devices: %w", group.DeviceClassName, group.Driver, err)
+		}
+		for _, selector := range class.Spec.Selectors {
+			if selector.CEL == nil {
+				continue
+			}
+			result := dracel.GetCompiler(dracel.Features{}).CompileCELExpression(selector.CEL.Expression, dracel.Options{})
+			if result.Error != nil {
+				return fmt.Errorf("cannot compile a selector of device class %q: %w", group.DeviceClassName, result.Error)
+			}
+			matches, _, err := result.DeviceMatches(context.Background(), dracel.Device{
+				Driver:     group.Driver,
+				Attributes: group.Attributes,
+				Capacity:   group.Capacity,
+			})
+			if err != nil {
+				return fmt.Errorf("cannot evaluate a selector of device class %q: %w", group.DeviceClassName, err)
+			}
+			if !matches {
+				return fmt.Errorf("the %s devices %q don't match the selector %q of device class %q", group.Driver, group.Name, selector.CEL.Expression, group.DeviceClassName)
+			}
+		}
+	}
+	return nil
+}
+
 func systemInfoFromInfrastructureObject(infraobj *unstructured.Unstructured) corev1.NodeSystemInfo {
 	nsi := corev1.NodeSystemInfo{}
 	infransi, found, err := unstructured.NestedStringMap(infraobj.Object, "status", "nodeInfo")
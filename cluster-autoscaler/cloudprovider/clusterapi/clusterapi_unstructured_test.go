@@ -19,6 +19,7 @@ package clusterapi
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	resourcelisters "k8s.io/client-go/listers/resource/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 )
@@ -450,6 +452,248 @@ func TestAnnotations(t *testing.T) {
 	})
 }
 
+func TestInstanceResourceSlicesFromInfrastructure(t *testing.T) {
+	testNodeName := "test-node"
+	infraObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind":       "TestMachineTemplate",
+			"apiVersion": "infrastructure.cluster.x-k8s.io/v1beta1",
+			"metadata": map[string]interface{}{
+				"name": "test-template",
+			},
+			"status": map[string]interface{}{
+				"devices": []interface{}{
+					map[string]interface{}{
+						"driver": "gpu.example.com",
+						"count":  int64(2),
+						"attributes": map[string]interface{}{
+							"type":  "GPU",
+							"cores": int64(6912),
+							"mig":   false,
+						},
+						"capacity": map[string]interface{}{
+							"memory": "80Gi",
+						},
+					},
+					map[string]interface{}{
+						"driver": "nic.example.com",
+						"name":   "nic",
+						"count":  int64(1),
+					},
+					map[string]interface{}{
+						"driver": "gpu.example.com",
+						"count":  int64(0),
+					},
+				},
+			},
+		},
+	}
+
+	expectedGPUDevice := func(name string) resourceapi.Device {
+		return resourceapi.Device{
+			Name: name,
+			Attributes: map[resourceapi.QualifiedName]resourceapi.DeviceAttribute{
+				"type":  {StringValue: ptr.To("GPU")},
+				"cores": {IntValue: ptr.To(int64(6912))},
+				"mig":   {BoolValue: ptr.To(false)},
+			},
+			Capacity: map[resourceapi.QualifiedName]resourceapi.DeviceCapacity{
+				"memory": {Value: resource.MustParse("80Gi")},
+			},
+		}
+	}
+	expectedResourceSlices := []*resourceapi.ResourceSlice{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: testNodeName + "-gpu.example.com"},
+			Spec: resourceapi.ResourceSliceSpec{
+				Driver:   "gpu.example.com",
+				NodeName: &testNodeName,
+				Pool:     resourceapi.ResourcePool{Name: testNodeName, ResourceSliceCount: 1},
+				Devices:  []resourceapi.Device{expectedGPUDevice("gpu-0"), expectedGPUDevice("gpu-1")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: testNodeName + "-nic.example.com"},
+			Spec: resourceapi.ResourceSliceSpec{
+				Driver:   "nic.example.com",
+				NodeName: &testNodeName,
+				Pool:     resourceapi.ResourcePool{Name: testNodeName, ResourceSliceCount: 1},
+				Devices:  []resourceapi.Device{{Name: "nic-0"}},
+			},
+		},
+	}
+
+	test := func(t *testing.T, testConfig *TestConfig, testResource *unstructured.Unstructured) {
+		// The devices are read from the machine template the scalable
+		// resource references through the infrastructure lookup.
+		devices, _, err := unstructured.NestedSlice(infraObj.Object, "status", "devices")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := unstructured.SetNestedSlice(testConfig.machineTemplate.Object, devices, "status", "devices"); err != nil {
+			t.Fatal(err)
+		}
+
+		controller := NewTestMachineController(t)
+		defer controller.Stop()
+		controller.AddTestConfigs(testConfig)
+
+		sr, err := newUnstructuredScalableResource(controller.machineController, testResource)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resourceSlices, err := sr.InstanceResourceSlices(testNodeName)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedResourceSlices, resourceSlices)
+	}
+
+	t.Run("MachineDeployment", func(t *testing.T) {
+		testConfig := NewTestConfigBuilder().
+			ForMachineDeployment().
+			WithNodeCount(1).
+			WithCapacity(map[string]string{cpuKey: "16"}).
+			Build()
+		test(t, testConfig, testConfig.machineDeployment)
+	})
+
+	t.Run("MachinePool", func(t *testing.T) {
+		testConfig := NewTestConfigBuilder().
+			ForMachinePool().
+			WithNodeCount(1).
+			WithCapacity(map[string]string{cpuKey: "16"}).
+			Build()
+		test(t, testConfig, testConfig.machinePool)
+	})
+
+	t.Run("invalid devices", func(t *testing.T) {
+		invalid := infraObj.DeepCopy()
+		if err := unstructured.SetNestedSlice(invalid.Object, []interface{}{
+			map[string]interface{}{"count": int64(1)},
+		}, "status", "devices"); err != nil {
+			t.Fatal(err)
+		}
+		_, err := draDevicesFromInfrastructureObject(invalid)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid device name", func(t *testing.T) {
+		invalid := infraObj.DeepCopy()
+		if err := unstructured.SetNestedSlice(invalid.Object, []interface{}{
+			map[string]interface{}{"driver": "gpu.example.com", "name": "GPU_0", "count": int64(1)},
+		}, "status", "devices"); err != nil {
+			t.Fatal(err)
+		}
+		_, err := draDevicesFromInfrastructureObject(invalid)
+		assert.Error(t, err)
+	})
+}
+
+func TestResourceSlicesFromDRADevicesSplitsPools(t *testing.T) {
+	groups := []draDeviceGroup{
+		{Driver: "gpu.example.com", Name: "gpu", Count: 100},
+		{Driver: "nic.example.com", Name: "nic", Count: 1},
+		{Driver: "gpu.example.com", Name: "gpu", Count: 100},
+	}
+
+	resourceSlices := resourceSlicesFromDRADevices("test-node", groups)
+	var names []string
+	var deviceCounts []int
+	for _, resourceSlice := range resourceSlices {
+		names = append(names, resourceSlice.Name)
+		deviceCounts = append(deviceCounts, len(resourceSlice.Spec.Devices))
+		expectedSliceCount := int64(1)
+		if resourceSlice.Spec.Driver == "gpu.example.com" {
+			expectedSliceCount = 2
+		}
+		assert.Equal(t, expectedSliceCount, resourceSlice.Spec.Pool.ResourceSliceCount, resourceSlice.Name)
+	}
+	assert.Equal(t, []string{"test-node-gpu.example.com", "test-node-gpu.example.com-1", "test-node-nic.example.com"}, names)
+	assert.Equal(t, []int{resourceapi.ResourceSliceMaxDevices, 200 - resourceapi.ResourceSliceMaxDevices, 1}, deviceCounts)
+	// device names stay unique over the slices of a pool
+	assert.Equal(t, "gpu-128", resourceSlices[1].Spec.Devices[0].Name)
+}
+
+func TestDRADevicesFromInfrastructureObjectLimitsDevices(t *testing.T) {
+	infraObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "TestMachineTemplate",
+		"metadata": map[string]interface{}{"name": "test-template"},
+	}}
+	if err := unstructured.SetNestedSlice(infraObj.Object, []interface{}{
+		map[string]interface{}{"driver": "gpu.example.com", "count": int64(maxDRADevicesPerNode)},
+		map[string]interface{}{"driver": "nic.example.com", "count": int64(1)},
+	}, "status", "devices"); err != nil {
+		t.Fatal(err)
+	}
+	_, err := draDevicesFromInfrastructureObject(infraObj)
+	assert.Error(t, err)
+}
+
+func TestCheckDRADeviceClasses(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for name, expression := range map[string]string{
+		"gpu.example.com": `device.driver == "gpu.example.com"`,
+		"a100":            `device.driver == "gpu.example.com" && device.attributes["gpu.example.com"].model == "a100"`,
+	} {
+		if err := indexer.Add(&resourceapi.DeviceClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: resourceapi.DeviceClassSpec{
+				Selectors: []resourceapi.DeviceSelector{{CEL: &resourceapi.CELDeviceSelector{Expression: expression}}},
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lister := resourcelisters.NewDeviceClassLister(indexer)
+	gpus := func(deviceClassName, model string) draDeviceGroup {
+		return draDeviceGroup{
+			Driver:          "gpu.example.com",
+			Name:            "gpu",
+			Count:           1,
+			DeviceClassName: deviceClassName,
+			Attributes: map[resourceapi.QualifiedName]resourceapi.DeviceAttribute{
+				"model": {StringValue: ptr.To(model)},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		lister  resourcelisters.DeviceClassLister
+		group   draDeviceGroup
+		wantErr bool
+	}{
+		{name: "no device class", lister: lister, group: gpus("", "h100")},
+		{name: "matching driver", lister: lister, group: gpus("gpu.example.com", "h100")},
+		{name: "matching attribute", lister: lister, group: gpus("a100", "a100")},
+		{name: "mismatching attribute", lister: lister, group: gpus("a100", "h100"), wantErr: true},
+		{name: "unknown device class", lister: lister, group: gpus("tpu.example.com", "a100"), wantErr: true},
+		{name: "DRA disabled", group: gpus("tpu.example.com", "a100")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkDRADeviceClasses(tc.lister, []draDeviceGroup{tc.group})
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDRADeviceNamePrefix(t *testing.T) {
+	for deviceType, expected := range map[string]string{
+		"gpu":                   "gpu",
+		"GPU":                   "gpu",
+		"NVIDIA A100":           "nvidia-a100",
+		"tpu_v5e  (lite)":       "tpu-v5e-lite",
+		"__":                    "",
+		strings.Repeat("a", 70): strings.Repeat("a", maxDRADeviceNamePrefixLength),
+	} {
+		assert.Equal(t, expected, draDeviceNamePrefix(deviceType), deviceType)
+	}
+}
+
 func TestCanScaleFromZero(t *testing.T) {
 	testConfigs := []struct {
 		name        string
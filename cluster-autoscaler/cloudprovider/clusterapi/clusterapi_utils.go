@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"k8s.io/klog/v2"
 
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/cluster-autoscaler/pkg/config"
 )
 
 const (
@@ -45,6 +47,11 @@ const (
 	machineDeploymentRevisionAnnotation = "machinedeployment.clusters.x-k8s.io/revision"
 	machineDeploymentNameLabel          = "cluster.x-k8s.io/deployment-name"
 	resourcePausedAnnotation            = "cluster.x-k8s.io/paused"
+	// zeroOrMaxNodeScalingOptionKey is the autoscaling option key for NodeGroupAutoscalingOptions.ZeroOrMaxNodeScaling,
+	// the other option keys are shared with the config package.
+	zeroOrMaxNodeScalingOptionKey = "zeroormaxnodescaling"
+	// invalidAutoscalingOptionEventReason is the reason of the events emitted for invalid autoscaling options.
+	invalidAutoscalingOptionEventReason = "InvalidAutoscalingOption"
 	// UnknownArch is used if the Architecture is Unknown
 	UnknownArch SystemArchitecture = ""
 	// Amd64 is used if the Architecture is x86_64
@@ -172,6 +179,87 @@ func autoscalingOptions(annotations map[string]string) map[string]string {
 	return options
 }
 
+// autoscalingOptionSetters maps the autoscaling option keys, as found after the
+// nodeGroupAutoscalingOptionsKeyPrefix of the annotations, to functions that
+// validate the value and set the related NodeGroupAutoscalingOptions field.
+var autoscalingOptionSetters = map[string]func(*config.NodeGroupAutoscalingOptions, string) error{
+	config.DefaultScaleDownUtilizationThresholdKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setRatioOption(&o.ScaleDownUtilizationThreshold, v)
+	},
+	config.DefaultScaleDownGpuUtilizationThresholdKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setRatioOption(&o.ScaleDownGpuUtilizationThreshold, v)
+	},
+	config.DefaultScaleDownUnneededTimeKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setDurationOption(&o.ScaleDownUnneededTime, v)
+	},
+	config.DefaultScaleDownUnreadyTimeKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setDurationOption(&o.ScaleDownUnreadyTime, v)
+	},
+	config.DefaultMaxNodeProvisionTimeKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setDurationOption(&o.MaxNodeProvisionTime, v)
+	},
+	config.DefaultMaxNodeStartupTimeKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setDurationOption(&o.MaxNodeStartupTime, v)
+	},
+	config.DefaultIgnoreDaemonSetsUtilizationKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setBoolOption(&o.IgnoreDaemonSetsUtilization, v)
+	},
+	zeroOrMaxNodeScalingOptionKey: func(o *config.NodeGroupAutoscalingOptions, v string) error {
+		return setBoolOption(&o.ZeroOrMaxNodeScaling, v)
+	},
+}
+
+// applyAutoscalingOptions overrides the fields of opts with the values of the
+// autoscaling options. Options that are unknown or fail validation are left
+// out, and an error is returned for each of them.
+func applyAutoscalingOptions(opts *config.NodeGroupAutoscalingOptions, options map[string]string) []error {
+	var errs []error
+	for key, value := range options {
+		setter, found := autoscalingOptionSetters[key]
+		if !found {
+			errs = append(errs, fmt.Errorf("unknown autoscaling option %q", nodeGroupAutoscalingOptionsKeyPrefix+key))
+			continue
+		}
+		if err := setter(opts, value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value %q for autoscaling option %q: %v", value, nodeGroupAutoscalingOptionsKeyPrefix+key, err))
+		}
+	}
+	return errs
+}
+
+func setRatioOption(field *float64, value string) error {
+	option, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	if option < 0 || option > 1 {
+		return fmt.Errorf("must be between 0 and 1")
+	}
+	*field = option
+	return nil
+}
+
+func setDurationOption(field *time.Duration, value string) error {
+	option, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	if option < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	*field = option
+	return nil
+}
+
+func setBoolOption(field *bool, value string) error {
+	option, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+	*field = option
+	return nil
+}
+
 // maxSize returns the maximum value encoded in the annotations keyed
 // by nodeGroupMaxSizeAnnotationKey. Returns errMissingMaxAnnotation
 // if the annotation doesn't exist or errInvalidMaxAnnotation if the
@@ -248,6 +336,28 @@ func normalizedProviderString(s string) normalizedProviderID {
 	return normalizedProviderID(split[len(split)-1])
 }
 
+// machineHasConditionStatus returns true if the machine reports a condition
+// of the given type and status, either in status.conditions or, for
+// v1beta1 machines, in status.v1beta2.conditions.
+func machineHasConditionStatus(machine *unstructured.Unstructured, conditionType, status string) bool {
+	for _, fields := range [][]string{{"status", "conditions"}, {"status", "v1beta2", "conditions"}} {
+		conditions, found, err := unstructured.NestedSlice(machine.UnstructuredContent(), fields...)
+		if err != nil || !found {
+			continue
+		}
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if condition["type"] == conditionType && condition["status"] == status {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func parseKey(annotations map[string]string, key string) (resource.Quantity, error) {
 	if val, exists := annotations[key]; exists && val != "" {
 		return resource.ParseQuantity(val)
@@ -161,7 +161,7 @@ func (pcp *equinixMetalCloudProvider) HasInstance(node *apiv1.Node) (bool, error
 
 // Pricing returns pricing model for this cloud provider or error if not available.
 func (pcp *equinixMetalCloudProvider) Pricing() (cloudprovider.PricingModel, errors.AutoscalerError) {
-	return &Price{}, nil
+	return pcp.equinixMetalManager.pricingModel(), nil
 }
 
 // GetAvailableMachineTypes is not implemented.
@@ -47,6 +47,7 @@ type equinixMetalManager interface {
 	deleteNodes(nodegroup string, nodes []NodeRef, updatedNodeCount int) error
 	templateNodeInfo(nodegroup string) (*framework.NodeInfo, error)
 	NodeGroupForNode(labels map[string]string, nodeId string) (string, error)
+	pricingModel() *Price
 }
 
 // createEquinixMetalManager creates the desired implementation of equinixMetalManager.
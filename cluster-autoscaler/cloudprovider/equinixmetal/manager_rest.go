@@ -159,31 +159,46 @@ type equinixMetalManagerNodePool struct {
 	cloudinit         string
 	reservation       string
 	hostnamePattern   string
+	spotInstance      bool
+	spotPriceMax      float64
 }
 
 type equinixMetalManagerRest struct {
 	authToken                    string
 	equinixMetalManagerNodePools map[string]*equinixMetalManagerNodePool
+	price                        *Price
 }
 
 // ConfigNodepool options only include the project-id for now
 type ConfigNodepool struct {
-	ClusterName       string `gcfg:"cluster-name"`
-	ProjectID         string `gcfg:"project-id"`
-	APIServerEndpoint string `gcfg:"api-server-endpoint"`
-	Metro             string `gcfg:"metro"`
-	Plan              string `gcfg:"plan"`
-	OS                string `gcfg:"os"`
-	Billing           string `gcfg:"billing"`
-	CloudInit         string `gcfg:"cloudinit"`
-	Reservation       string `gcfg:"reservation"`
-	HostnamePattern   string `gcfg:"hostname-pattern"`
+	ClusterName       string  `gcfg:"cluster-name"`
+	ProjectID         string  `gcfg:"project-id"`
+	APIServerEndpoint string  `gcfg:"api-server-endpoint"`
+	Metro             string  `gcfg:"metro"`
+	Plan              string  `gcfg:"plan"`
+	OS                string  `gcfg:"os"`
+	Billing           string  `gcfg:"billing"`
+	CloudInit         string  `gcfg:"cloudinit"`
+	Reservation       string  `gcfg:"reservation"`
+	HostnamePattern   string  `gcfg:"hostname-pattern"`
+	SpotInstance      bool    `gcfg:"spot-instance"`
+	SpotPriceMax      float64 `gcfg:"spot-price-max"`
+}
+
+// ConfigPlanPrice holds the hourly prices of a plan in USD. Metro prices are
+// given as <metro>:<price> and may be repeated.
+type ConfigPlanPrice struct {
+	Hourly          float64  `gcfg:"hourly"`
+	MetroHourly     []string `gcfg:"metro-hourly"`
+	SpotHourly      float64  `gcfg:"spot-hourly"`
+	MetroSpotHourly []string `gcfg:"metro-spot-hourly"`
 }
 
 // ConfigFile is used to read and store information from the cloud configuration file
 type ConfigFile struct {
-	DefaultNodegroupdef ConfigNodepool             `gcfg:"global"`
-	Nodegroupdef        map[string]*ConfigNodepool `gcfg:"nodegroupdef"`
+	DefaultNodegroupdef ConfigNodepool              `gcfg:"global"`
+	Nodegroupdef        map[string]*ConfigNodepool  `gcfg:"nodegroupdef"`
+	PlanPrice           map[string]*ConfigPlanPrice `gcfg:"plan-price"`
 }
 
 // Device represents an Equinix Metal device
@@ -221,6 +236,8 @@ type DeviceCreateRequest struct {
 	CustomData            string                   `json:"customdata,omitempty"`
 	IPAddresses           []IPAddressCreateRequest `json:"ip_addresses,omitempty"`
 	HardwareReservationID string                   `json:"hardware_reservation_id,omitempty"`
+	SpotInstance          bool                     `json:"spot_instance,omitempty"`
+	SpotPriceMax          float64                  `json:"spot_price_max,omitempty"`
 }
 
 // CloudInitTemplateData represents the variables that can be used in cloudinit templates
@@ -279,6 +296,7 @@ func createEquinixMetalManagerRest(configReader io.Reader, discoverOpts cloudpro
 	cfg := ConfigFile{
 		DefaultNodegroupdef: ConfigNodepool{},
 		Nodegroupdef:        map[string]*ConfigNodepool{},
+		PlanPrice:           map[string]*ConfigPlanPrice{},
 	}
 
 	if configReader != nil {
@@ -331,9 +349,17 @@ func createEquinixMetalManagerRest(configReader io.Reader, discoverOpts cloudpro
 			cloudinit:         cfg.Nodegroupdef[nodepool].CloudInit,
 			reservation:       cfg.Nodegroupdef[nodepool].Reservation,
 			hostnamePattern:   cfg.Nodegroupdef[nodepool].HostnamePattern,
+			spotInstance:      cfg.Nodegroupdef[nodepool].SpotInstance,
+			spotPriceMax:      cfg.Nodegroupdef[nodepool].SpotPriceMax,
 		}
 	}
 
+	planPrices, err := buildPlanPrices(cfg.PlanPrice)
+	if err != nil {
+		return nil, err
+	}
+	manager.price = &Price{planPrices: planPrices, nodePools: manager.equinixMetalManagerNodePools}
+
 	return &manager, nil
 }
 
@@ -421,6 +447,15 @@ func (mgr *equinixMetalManagerRest) getEquinixMetalDevice(ctx context.Context, i
 	return &device, nil
 }
 
+// pricingModel returns the price model built from the plan prices in the
+// configuration file.
+func (mgr *equinixMetalManagerRest) pricingModel() *Price {
+	if mgr.price == nil {
+		return &Price{nodePools: mgr.equinixMetalManagerNodePools}
+	}
+	return mgr.price
+}
+
 func (mgr *equinixMetalManagerRest) NodeGroupForNode(labels map[string]string, nodeId string) (string, error) {
 	if nodegroup, ok := labels["pool"]; ok {
 		return nodegroup, nil
@@ -536,6 +571,8 @@ func (mgr *equinixMetalManagerRest) createDevice(ctx context.Context, hostname,
 		UserData:              userData,
 		Tags:                  []string{"k8s-cluster-" + mgr.getNodePoolDefinition(nodegroup).clusterName, "k8s-nodepool-" + nodegroup},
 		HardwareReservationID: reservation,
+		SpotInstance:          mgr.getNodePoolDefinition(nodegroup).spotInstance,
+		SpotPriceMax:          mgr.getNodePoolDefinition(nodegroup).spotPriceMax,
 	}
 
 	if err := mgr.createDeviceRequest(ctx, cr, nodegroup); err != nil {
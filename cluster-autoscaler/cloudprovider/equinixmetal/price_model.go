@@ -17,7 +17,10 @@ limitations under the License.
 package equinixmetal
 
 import (
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -27,6 +30,19 @@ import (
 
 // Price implements Price interface for Equinix Metal.
 type Price struct {
+	// planPrices holds the prices configured per plan, overriding instancePrices.
+	planPrices map[string]*planPrice
+	// nodePools is used to find the metro and spot settings of a node's pool.
+	nodePools map[string]*equinixMetalManagerNodePool
+}
+
+// planPrice holds the hourly prices of a plan. Metro prices take precedence
+// over the plan-wide ones.
+type planPrice struct {
+	hourly          float64
+	metroHourly     map[string]float64
+	spotHourly      float64
+	metroSpotHourly map[string]float64
 }
 
 const (
@@ -54,15 +70,87 @@ var instancePrices = map[string]float64{
 // NodePrice returns a price of running the given node for a given period of time.
 // All prices are in USD.
 func (model *Price) NodePrice(node *apiv1.Node, startTime time.Time, endTime time.Time) (float64, error) {
-	price := 0.0
-	if node.Labels != nil {
-		if machineType, found := node.Labels[apiv1.LabelInstanceType]; found {
-			if pricePerHour, found := instancePrices[machineType]; found {
-				price = pricePerHour * getHours(startTime, endTime)
+	plan, found := node.Labels[apiv1.LabelInstanceType]
+	if !found {
+		return 0.0, nil
+	}
+	metro := node.Labels[apiv1.LabelTopologyRegion]
+	spot, spotPriceMax := false, 0.0
+	if pool, found := model.nodePools[node.Labels["pool"]]; found {
+		if metro == "" {
+			metro = pool.metro
+		}
+		spot, spotPriceMax = pool.spotInstance, pool.spotPriceMax
+	}
+	return model.hourlyPrice(plan, strings.ToLower(metro), spot, spotPriceMax) * getHours(startTime, endTime), nil
+}
+
+// hourlyPrice returns the hourly price of a plan in a metro. Spot instances are
+// priced at the configured spot price, or at their maximum bid if there is none.
+// Prices not found in the configuration fall back to the built-in ones.
+func (model *Price) hourlyPrice(plan, metro string, spot bool, spotPriceMax float64) float64 {
+	prices := model.planPrices[plan]
+	if spot {
+		if prices != nil {
+			if price, found := prices.metroSpotHourly[metro]; found {
+				return price
+			}
+			if prices.spotHourly > 0 {
+				return prices.spotHourly
 			}
 		}
+		if spotPriceMax > 0 {
+			return spotPriceMax
+		}
+	}
+	if prices != nil {
+		if price, found := prices.metroHourly[metro]; found {
+			return price
+		}
+		if prices.hourly > 0 {
+			return prices.hourly
+		}
+	}
+	return instancePrices[plan]
+}
+
+// buildPlanPrices converts the plan-price sections of the configuration file.
+func buildPlanPrices(cfg map[string]*ConfigPlanPrice) (map[string]*planPrice, error) {
+	result := make(map[string]*planPrice, len(cfg))
+	for plan, c := range cfg {
+		metroHourly, err := parseMetroPrices(c.MetroHourly)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metro-hourly price of plan %s: %w", plan, err)
+		}
+		metroSpotHourly, err := parseMetroPrices(c.MetroSpotHourly)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metro-spot-hourly price of plan %s: %w", plan, err)
+		}
+		result[plan] = &planPrice{
+			hourly:          c.Hourly,
+			metroHourly:     metroHourly,
+			spotHourly:      c.SpotHourly,
+			metroSpotHourly: metroSpotHourly,
+		}
+	}
+	return result, nil
+}
+
+// parseMetroPrices parses prices given as <metro>:<price>.
+func parseMetroPrices(values []string) (map[string]float64, error) {
+	result := make(map[string]float64, len(values))
+	for _, value := range values {
+		metro, price, found := strings.Cut(value, ":")
+		if !found || metro == "" {
+			return nil, fmt.Errorf("%q is not of the form <metro>:<price>", value)
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(price), 64)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("%q has an invalid price", value)
+		}
+		result[strings.ToLower(strings.TrimSpace(metro))] = parsed
 	}
-	return price, nil
+	return result, nil
 }
 
 func getHours(startTime time.Time, endTime time.Time) float64 {
@@ -18,6 +18,7 @@ package equinixmetal
 
 import (
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"sigs.k8s.io/cluster-autoscaler/pkg/utils/units"
 
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/gcfg.v1"
 )
 
 func TestGetNodePrice(t *testing.T) {
@@ -65,3 +67,70 @@ func TestGetPodPrice(t *testing.T) {
 	// 2 times bigger pod should cost twice as much.
 	assert.True(t, math.Abs(price1*2-price2) < 0.001)
 }
+
+func TestGetNodePriceFromPlanPrices(t *testing.T) {
+	cfg := ConfigFile{}
+	err := gcfg.ReadInto(&cfg, strings.NewReader(`
+[plan-price "m3.small.x86"]
+hourly = 1.5
+metro-hourly = da:1.75
+metro-hourly = AM:1.25
+spot-hourly = 0.4
+metro-spot-hourly = da:0.5
+
+[plan-price "c3.medium.x86"]
+metro-hourly = sv:1.6
+`))
+	assert.NoError(t, err)
+	planPrices, err := buildPlanPrices(cfg.PlanPrice)
+	assert.NoError(t, err)
+
+	model := &Price{
+		planPrices: planPrices,
+		nodePools: map[string]*equinixMetalManagerNodePool{
+			"default": {metro: "da"},
+			"spot":    {metro: "am", spotInstance: true},
+			"bid":     {metro: "sv", spotInstance: true, spotPriceMax: 0.6},
+		},
+	}
+	now := time.Now()
+
+	for _, tc := range []struct {
+		name     string
+		pool     string
+		plan     string
+		region   string
+		expected float64
+	}{
+		{name: "metro price of pool", pool: "default", plan: "m3.small.x86", expected: 1.75},
+		{name: "metro price of region label", pool: "default", plan: "m3.small.x86", region: "am", expected: 1.25},
+		{name: "plan price without metro price", pool: "default", plan: "m3.small.x86", region: "ny", expected: 1.5},
+		{name: "built-in price without plan price", pool: "default", plan: "c3.medium.x86", expected: 1.35},
+		{name: "metro price of unknown pool", pool: "other", plan: "c3.medium.x86", region: "sv", expected: 1.6},
+		{name: "metro spot price", pool: "spot", plan: "m3.small.x86", region: "da", expected: 0.5},
+		{name: "plan spot price", pool: "spot", plan: "m3.small.x86", expected: 0.4},
+		{name: "maximum spot bid without spot price", pool: "bid", plan: "c3.medium.x86", expected: 0.6},
+		{name: "on-demand price without spot price", pool: "spot", plan: "c3.medium.x86", expected: 1.35},
+		{name: "unknown plan", pool: "default", plan: "unknown", expected: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			node := BuildTestNode("node", 1000, 1024*1024*1024)
+			node.Labels = BuildGenericLabels(tc.pool, tc.plan)
+			if tc.region != "" {
+				node.Labels["topology.kubernetes.io/region"] = tc.region
+			}
+			price, err := model.NodePrice(node, now, now.Add(2*time.Hour))
+			assert.NoError(t, err)
+			assert.InDelta(t, 2*tc.expected, price, 1e-9)
+		})
+	}
+}
+
+func TestBuildPlanPricesInvalid(t *testing.T) {
+	for _, metroPrice := range []string{"da", ":1.0", "da:abc", "da:-1"} {
+		_, err := buildPlanPrices(map[string]*ConfigPlanPrice{
+			"m3.small.x86": {MetroHourly: []string{metroPrice}},
+		})
+		assert.Error(t, err, metroPrice)
+	}
+}
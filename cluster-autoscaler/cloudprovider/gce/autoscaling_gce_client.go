@@ -260,7 +260,13 @@ func (client *autoscalingGceClientV1) FetchMig(migRef GceRef) (*gce.InstanceGrou
 	registerRequest("instance_group_managers", "get")
 	ctx, cancel := context.WithTimeout(context.Background(), client.operationPerCallTimeout)
 	defer cancel()
-	igm, err := client.gceService.InstanceGroupManagers.Get(migRef.Project, migRef.Zone, migRef.Name).Context(ctx).Do()
+	var igm *gce.InstanceGroupManager
+	var err error
+	if migRef.Regional {
+		igm, err = client.gceService.RegionInstanceGroupManagers.Get(migRef.Project, migRef.Zone, migRef.Name).Context(ctx).Do()
+	} else {
+		igm, err = client.gceService.InstanceGroupManagers.Get(migRef.Project, migRef.Zone, migRef.Name).Context(ctx).Do()
+	}
 	if err != nil {
 		if err, ok := err.(*googleapi.Error); ok {
 			if err.Code == http.StatusNotFound {
@@ -300,14 +306,25 @@ func (client *autoscalingGceClientV1) ResizeMig(migRef GceRef, size int64) error
 	registerRequest("instance_group_managers", "resize")
 	ctx, cancel := context.WithTimeout(context.Background(), client.operationPerCallTimeout)
 	defer cancel()
-	op, err := client.gceService.InstanceGroupManagers.Resize(migRef.Project, migRef.Zone, migRef.Name, size).Context(ctx).Do()
+	var op *gce.Operation
+	var err error
+	if migRef.Regional {
+		op, err = client.gceService.RegionInstanceGroupManagers.Resize(migRef.Project, migRef.Zone, migRef.Name, size).Context(ctx).Do()
+	} else {
+		op, err = client.gceService.InstanceGroupManagers.Resize(migRef.Project, migRef.Zone, migRef.Name, size).Context(ctx).Do()
+	}
 	if err != nil {
 		return err
 	}
-	return client.WaitForOperation(op.Name, op.OperationType, migRef.Project, migRef.Zone)
+	return client.waitForMigOperation(op, migRef)
 }
 
 func (client *autoscalingGceClientV1) CreateInstances(migRef GceRef, baseName string, delta int64, existingInstanceProviderIds []string) ([]string, error) {
+	if migRef.Regional {
+		// Instances in a regional MIG are placed in zones by its distribution policy,
+		// so their names and provider ids can't be known upfront.
+		return nil, fmt.Errorf("creating named instances is not supported for regional mig %s", migRef)
+	}
 	registerRequest("instance_group_managers", "create_instances")
 	ctx, cancel := context.WithTimeout(context.Background(), client.operationPerCallTimeout)
 	defer cancel()
@@ -319,7 +336,7 @@ func (client *autoscalingGceClientV1) CreateInstances(migRef GceRef, baseName st
 		newInstanceName := generateInstanceName(baseName, instanceNames)
 		instanceNames[newInstanceName] = true
 		req.Instances = append(req.Instances, &gce.PerInstanceConfig{Name: newInstanceName})
-		ref := GceRef{migRef.Project, migRef.Zone, newInstanceName}
+		ref := GceRef{Project: migRef.Project, Zone: migRef.Zone, Name: newInstanceName}
 		createdIds[i] = ref.ToProviderId()
 	}
 
@@ -348,18 +365,40 @@ func instanceIdsToNamesMap(instanceProviderIds []string) map[string]bool {
 // Calling this is normally not needed when interacting with the client, other methods should call it internally.
 // Can be used to extend the interface with more methods outside of this package.
 func (client *autoscalingGceClientV1) WaitForOperation(operationName, operationType, project, zone string) error {
+	return client.waitForOperation(operationName, operationType, project, zone, func(ctx context.Context) (*gce.Operation, error) {
+		registerRequest("zone_operations", "wait")
+		return client.gceService.ZoneOperations.Wait(project, zone, operationName).Context(ctx).Do()
+	})
+}
+
+// waitForRegionOperation is the counterpart of WaitForOperation for operations on regional resources.
+func (client *autoscalingGceClientV1) waitForRegionOperation(operationName, operationType, project, region string) error {
+	return client.waitForOperation(operationName, operationType, project, region, func(ctx context.Context) (*gce.Operation, error) {
+		registerRequest("region_operations", "wait")
+		return client.gceService.RegionOperations.Wait(project, region, operationName).Context(ctx).Do()
+	})
+}
+
+// waitForMigOperation waits for an operation issued against a zonal or regional MIG.
+func (client *autoscalingGceClientV1) waitForMigOperation(op *gce.Operation, migRef GceRef) error {
+	if migRef.Regional {
+		return client.waitForRegionOperation(op.Name, op.OperationType, migRef.Project, migRef.Zone)
+	}
+	return client.WaitForOperation(op.Name, op.OperationType, migRef.Project, migRef.Zone)
+}
+
+func (client *autoscalingGceClientV1) waitForOperation(operationName, operationType, project, location string, wait func(context.Context) (*gce.Operation, error)) error {
 	ctx, cancel := context.WithTimeout(context.Background(), client.operationWaitTimeout)
 	defer cancel()
 
 	for {
-		klog.V(4).Infof("Waiting for operation %s/%s (%s/%s)", operationType, operationName, project, zone)
-		registerRequest("zone_operations", "wait")
-		op, err := client.gceService.ZoneOperations.Wait(project, zone, operationName).Context(ctx).Do()
+		klog.V(4).Infof("Waiting for operation %s/%s (%s/%s)", operationType, operationName, project, location)
+		op, err := wait(ctx)
 		if err != nil {
 			return fmt.Errorf("error while waiting for operation %s/%s: %w", operationType, operationName, err)
 		}
 
-		klog.V(4).Infof("Operation %s/%s (%s/%s) status: %s", operationType, operationName, project, zone, op.Status)
+		klog.V(4).Infof("Operation %s/%s (%s/%s) status: %s", operationType, operationName, project, location, op.Status)
 		if op.Status == "DONE" {
 			if op.Error != nil {
 				errBytes, err := op.Error.MarshalJSON()
@@ -388,11 +427,21 @@ func (client *autoscalingGceClientV1) DeleteInstances(migRef GceRef, instances [
 	for _, i := range instances {
 		req.Instances = append(req.Instances, GenerateInstanceUrl(client.domainUrl, i))
 	}
-	op, err := client.gceService.InstanceGroupManagers.DeleteInstances(migRef.Project, migRef.Zone, migRef.Name, &req).Context(ctx).Do()
+	var op *gce.Operation
+	var err error
+	if migRef.Regional {
+		regionalReq := gce.RegionInstanceGroupManagersDeleteInstancesRequest{
+			Instances:                      req.Instances,
+			SkipInstancesOnValidationError: req.SkipInstancesOnValidationError,
+		}
+		op, err = client.gceService.RegionInstanceGroupManagers.DeleteInstances(migRef.Project, migRef.Zone, migRef.Name, &regionalReq).Context(ctx).Do()
+	} else {
+		op, err = client.gceService.InstanceGroupManagers.DeleteInstances(migRef.Project, migRef.Zone, migRef.Name, &req).Context(ctx).Do()
+	}
 	if err != nil {
 		return err
 	}
-	return client.WaitForOperation(op.Name, op.OperationType, migRef.Project, migRef.Zone)
+	return client.waitForMigOperation(op, migRef)
 }
 
 func (client *autoscalingGceClientV1) FetchAllInstances(project, zone, filter string) ([]GceInstance, error) {
@@ -464,7 +513,14 @@ func createIgmRef(gceInstance *gce.Instance, project string, loggingQuota *klogx
 func (client *autoscalingGceClientV1) FetchMigInstances(migRef GceRef) ([]GceInstance, error) {
 	registerRequest("instance_group_managers", "list_managed_instances")
 	b := newInstanceListBuilder(migRef)
-	err := client.gceService.InstanceGroupManagers.ListManagedInstances(migRef.Project, migRef.Zone, migRef.Name).Pages(context.Background(), b.loadPage)
+	var err error
+	if migRef.Regional {
+		err = client.gceService.RegionInstanceGroupManagers.ListManagedInstances(migRef.Project, migRef.Zone, migRef.Name).Pages(context.Background(), func(page *gce.RegionInstanceGroupManagersListInstancesResponse) error {
+			return b.loadPage(&gce.InstanceGroupManagersListManagedInstancesResponse{ManagedInstances: page.ManagedInstances})
+		})
+	} else {
+		err = client.gceService.InstanceGroupManagers.ListManagedInstances(migRef.Project, migRef.Zone, migRef.Name).Pages(context.Background(), b.loadPage)
+	}
 	if err != nil {
 		klog.V(4).Infof("Failed MIG info request for %s %s %s: %v", migRef.Project, migRef.Zone, migRef.Name, err)
 		return nil, err
@@ -544,6 +600,10 @@ func (i *instanceListBuilder) gceInstanceToInstance(ref GceRef, gceInstance *gce
 	}
 	if errorInfo != nil {
 		errorInfo.ErrorMessage = strings.Join(errorMessages, "; ")
+		if i.migRef.Regional {
+			// Regional MIGs spread instances across zones, so the zone tells which one is stocked out.
+			errorInfo.ErrorMessage = fmt.Sprintf("zone %s: %s", ref.Zone, errorInfo.ErrorMessage)
+		}
 		instance.Status.ErrorInfo = errorInfo
 	}
 
@@ -824,8 +884,7 @@ func (client *autoscalingGceClientV1) FetchMigTemplate(migRef GceRef, templateNa
 	ctx, cancel := context.WithTimeout(context.Background(), client.operationPerCallTimeout)
 	defer cancel()
 	if regional {
-		zoneHyphenIndex := strings.LastIndex(migRef.Zone, "-")
-		region := migRef.Zone[:zoneHyphenIndex]
+		region := migRef.Region()
 		registerRequest("region_instance_templates", "get")
 		return client.gceService.RegionInstanceTemplates.Get(migRef.Project, region, templateName).Context(ctx).Do()
 	}
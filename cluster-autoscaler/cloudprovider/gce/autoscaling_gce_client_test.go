@@ -997,7 +997,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceCreating},
 					},
 					NumericId: 11,
-					Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 					GCEStatus: "PROVISIONING",
 				},
 			},
@@ -1061,7 +1061,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 10,
-					Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 					GCEStatus: "RUNNING",
 				},
 				{
@@ -1070,7 +1070,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 11,
-					Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 					GCEStatus: "RUNNING",
 				},
 			},
@@ -1160,7 +1160,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 10,
-					Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 					GCEStatus: "RUNNING",
 				},
 				{
@@ -1169,7 +1169,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 11,
-					Igm:       GceRef{"myprojid", "zones", "test-igm2-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm2-grp"},
 					GCEStatus: "RUNNING",
 				},
 				{
@@ -1178,7 +1178,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 12,
-					Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 					GCEStatus: "RUNNING",
 				},
 				{
@@ -1187,7 +1187,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 13,
-					Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 					GCEStatus: "RUNNING",
 				},
 				{
@@ -1196,7 +1196,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 14,
-					Igm:       GceRef{"myprojid", "zones", "test-igm2-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm2-grp"},
 					GCEStatus: "RUNNING",
 				},
 				{
@@ -1205,7 +1205,7 @@ func TestFetchAllInstances(t *testing.T) {
 						Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 					},
 					NumericId: 15,
-					Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+					Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 					GCEStatus: "RUNNING",
 				},
 			},
@@ -1311,7 +1311,7 @@ func TestExternalToInternalInstance(t *testing.T) {
 					Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 				},
 				NumericId: 10,
-				Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+				Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 				GCEStatus: "RUNNING",
 			},
 		},
@@ -1333,7 +1333,7 @@ func TestExternalToInternalInstance(t *testing.T) {
 					Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning},
 				},
 				NumericId: 10,
-				Igm:       GceRef{"myprojid", "zones", "test-igm1-grp"},
+				Igm:       GceRef{Project: "myprojid", Zone: "zones", Name: "test-igm1-grp"},
 				GCEStatus: "SUSPENDED",
 			},
 		},
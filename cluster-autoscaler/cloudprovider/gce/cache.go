@@ -76,6 +76,7 @@ type GceCache struct {
 	migBaseNameCache                 map[GceRef]string
 	migInstancesStateCountCache      map[GceRef]map[cloudprovider.InstanceState]int64
 	listManagedInstancesResultsCache map[GceRef]string
+	migDistributionPolicyCache       map[GceRef]MigDistributionPolicy
 	instanceTemplateNameCache        map[GceRef]InstanceTemplateName
 	instanceTemplatesCache           map[GceRef]*gce.InstanceTemplate
 	kubeEnvCache                     map[GceRef]KubeEnv
@@ -96,6 +97,7 @@ func NewGceCache() *GceCache {
 		migBaseNameCache:                 map[GceRef]string{},
 		migInstancesStateCountCache:      map[GceRef]map[cloudprovider.InstanceState]int64{},
 		listManagedInstancesResultsCache: map[GceRef]string{},
+		migDistributionPolicyCache:       map[GceRef]MigDistributionPolicy{},
 		instanceTemplateNameCache:        map[GceRef]InstanceTemplateName{},
 		instanceTemplatesCache:           map[GceRef]*gce.InstanceTemplate{},
 		kubeEnvCache:                     map[GceRef]KubeEnv{},
@@ -630,6 +632,28 @@ func (gc *GceCache) InvalidateAllListManagedInstancesResults() {
 	gc.listManagedInstancesResultsCache = make(map[GceRef]string)
 }
 
+// SetMigDistributionPolicy sets the distribution policy for a given regional mig in cache.
+func (gc *GceCache) SetMigDistributionPolicy(migRef GceRef, policy MigDistributionPolicy) {
+	gc.cacheMutex.Lock()
+	defer gc.cacheMutex.Unlock()
+	gc.migDistributionPolicyCache[migRef] = policy
+}
+
+// GetMigDistributionPolicy gets the distribution policy for a given regional mig from cache.
+func (gc *GceCache) GetMigDistributionPolicy(migRef GceRef) (MigDistributionPolicy, bool) {
+	gc.cacheMutex.Lock()
+	defer gc.cacheMutex.Unlock()
+	policy, found := gc.migDistributionPolicyCache[migRef]
+	return policy, found
+}
+
+// InvalidateAllMigDistributionPolicies invalidates all distribution policy entries.
+func (gc *GceCache) InvalidateAllMigDistributionPolicies() {
+	gc.cacheMutex.Lock()
+	defer gc.cacheMutex.Unlock()
+	gc.migDistributionPolicyCache = make(map[GceRef]MigDistributionPolicy)
+}
+
 // GetMigInstancesStateCount returns counts of instances in different states for the given mig from cache.
 func (gc *GceCache) GetMigInstancesStateCount(migRef GceRef) (instanceState map[cloudprovider.InstanceState]int64, found bool) {
 	gc.cacheMutex.Lock()
@@ -185,6 +185,8 @@ type GceRef struct {
 	Project string
 	Zone    string
 	Name    string
+	// Regional is set for regional MIGs, in which case Zone holds the region.
+	Regional bool
 }
 
 func (ref GceRef) String() string {
@@ -445,6 +447,9 @@ func BuildGCE(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDis
 		opts.Processors.NodeGroupSetProcessor = &nodegroupset.BalancingNodeGroupSetProcessor{
 			Comparator: nodegroupset.CreateGceNodeInfoComparator(opts.AutoscalingOptions.BalancingExtraIgnoredLabels, opts.AutoscalingOptions.NodeGroupSetRatios),
 		}
+		if opts.Processors.NodeGroupListProcessor != nil {
+			opts.Processors.NodeGroupListProcessor = NewRegionalMigNodeGroupListProcessor(opts.Processors.NodeGroupListProcessor, manager)
+		}
 	}
 
 	return provider
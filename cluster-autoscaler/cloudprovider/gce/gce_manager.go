@@ -24,6 +24,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -56,7 +57,14 @@ const (
 	migAutoDiscovererKeyPrefix   = "namePrefix"
 	migAutoDiscovererKeyMinNodes = "min"
 	migAutoDiscovererKeyMaxNodes = "max"
+	migAutoDiscovererKeyLabel    = "label"
+	migAutoDiscovererKeyTag      = "tag"
 	createInstancesRequestLimit  = 1000
+
+	// migTemplateMinNodesKey and migTemplateMaxNodesKey are instance template metadata
+	// keys that set the size bounds of MIGs autodiscovered by template labels or tags.
+	migTemplateMinNodesKey = "cluster-autoscaler-min-nodes"
+	migTemplateMaxNodesKey = "cluster-autoscaler-max-nodes"
 )
 
 var (
@@ -71,7 +79,11 @@ var (
 		migAutoDiscovererKeyPrefix,
 		migAutoDiscovererKeyMinNodes,
 		migAutoDiscovererKeyMaxNodes,
+		migAutoDiscovererKeyLabel,
+		migAutoDiscovererKeyTag,
 	}, ", ")
+
+	anyMigName = regexp.MustCompile(".+")
 )
 
 // GceManager handles GCE communication and data caching.
@@ -87,8 +99,12 @@ type GceManager interface {
 	GetMigNodes(mig Mig) ([]GceInstance, error)
 	// GetMigForInstance returns MIG to which the given instance belongs.
 	GetMigForInstance(instance GceRef) (Mig, error)
+	// GetMigZoneInstanceStates returns per zone instance counts and stockouts of a MIG.
+	GetMigZoneInstanceStates(mig Mig) ([]ZoneInstanceState, error)
 	// GetMigTemplateNode returns a template node for MIG.
 	GetMigTemplateNode(mig Mig) (*apiv1.Node, error)
+	// GetMigTemplateZones returns the zones a scale-up of a MIG can create instances in, most likely first.
+	GetMigTemplateZones(mig Mig) ([]string, error)
 	// GetResourceLimiter returns resource limiter.
 	GetResourceLimiter() (*cloudprovider.ResourceLimiter, error)
 	// GetMigSize gets MIG size.
@@ -126,6 +142,11 @@ type gceManagerImpl struct {
 	migAutoDiscoverySpecs    []migAutoDiscoveryConfig
 	reserved                 *GceReserved
 	localSSDDiskSizeProvider localssdsize.LocalSSDSizeProvider
+
+	// migTemplateMismatches remembers the instance templates that did not match the
+	// template selectors of an autodiscovery spec. Instance templates are immutable,
+	// so a MIG only needs to be checked again once it uses another template.
+	migTemplateMismatches map[migTemplateMatchKey]bool
 }
 
 // CreateGceManager constructs GceManager object.
@@ -311,6 +332,7 @@ func (m *gceManagerImpl) Refresh() error {
 	m.cache.InvalidateAllMigIsStable()
 	m.cache.InvalidateAllMigBasenames()
 	m.cache.InvalidateAllListManagedInstancesResults()
+	m.cache.InvalidateAllMigDistributionPolicies()
 	m.cache.InvalidateAllMigInstanceTemplateNames()
 	if m.lastRefresh.Add(refreshInterval).After(time.Now()) {
 		return nil
@@ -329,6 +351,9 @@ func (m *gceManagerImpl) CreateInstances(mig Mig, delta int64) error {
 	if delta == 0 {
 		return nil
 	}
+	if mig.GceRef().Regional {
+		return m.increaseRegionalMigSize(mig, delta)
+	}
 	instances, err := m.GetMigNodes(mig)
 	if err != nil {
 		return err
@@ -361,6 +386,43 @@ func (m *gceManagerImpl) CreateInstances(mig Mig, delta int64) error {
 	return nil
 }
 
+// increaseRegionalMigSize scales up a regional MIG by resizing it, which lets GCE
+// place the new instances according to the MIG's distribution policy.
+func (m *gceManagerImpl) increaseRegionalMigSize(mig Mig, delta int64) error {
+	policy, err := m.migInfoProvider.GetMigDistributionPolicy(mig.GceRef())
+	if err != nil {
+		return fmt.Errorf("can't upscale %s: failed to get distribution policy: %w", mig.GceRef(), err)
+	}
+	if policy.TargetShape == TargetShapeEven {
+		// EVEN MIGs won't skip a zone, so a stockout in any zone blocks the whole scale-up.
+		states, err := m.GetMigZoneInstanceStates(mig)
+		if err != nil {
+			return err
+		}
+		if zones := outOfResourcesZones(states); len(zones) > 0 {
+			return fmt.Errorf("can't upscale %s: zones %s are out of resources and target shape is %s", mig.GceRef(), strings.Join(zones, ","), TargetShapeEven)
+		}
+	}
+	size, err := m.GetMigSize(mig)
+	if err != nil {
+		return err
+	}
+	return m.SetMigSize(mig, size+delta)
+}
+
+// GetMigZoneInstanceStates returns per zone instance counts and stockouts of a MIG.
+func (m *gceManagerImpl) GetMigZoneInstanceStates(mig Mig) ([]ZoneInstanceState, error) {
+	policy, err := m.migInfoProvider.GetMigDistributionPolicy(mig.GceRef())
+	if err != nil {
+		return nil, err
+	}
+	instances, err := m.GetMigNodes(mig)
+	if err != nil {
+		return nil, err
+	}
+	return zoneInstanceStates(policy, instances), nil
+}
+
 func (m *gceManagerImpl) forceRefresh() error {
 	m.clearMachinesCache()
 	if err := m.fetchAutoMigs(); err != nil {
@@ -444,16 +506,12 @@ func (m *gceManagerImpl) buildMigFromSpec(s *dynamic.NodeGroupSpec) (Mig, error)
 	if err := s.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid node group spec: %v", err)
 	}
-	project, zone, name, err := ParseMigUrl(s.Name)
+	ref, err := ParseMigUrlRef(s.Name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse mig url: %s got error: %v", s.Name, err)
 	}
 	mig := &gceMig{
-		gceRef: GceRef{
-			Project: project,
-			Name:    name,
-			Zone:    zone,
-		},
+		gceRef:     ref,
 		gceManager: m,
 		minSize:    s.MinSize,
 		maxSize:    s.MaxSize,
@@ -469,14 +527,36 @@ func (m *gceManagerImpl) fetchAutoMigs() error {
 	var changed int32 = 0
 
 	toRegister := make([]Mig, 0)
-	for _, cfg := range m.migAutoDiscoverySpecs {
-		links, err := m.findMigsNamed(cfg.Re)
+	zoneTemplateUrls := make(map[string]zoneMigTemplateUrls)
+	mismatches := make(map[migTemplateMatchKey]bool)
+	for spec, cfg := range m.migAutoDiscoverySpecs {
+		name := cfg.Re
+		if name == nil {
+			name = anyMigName
+		}
+		links, err := m.findMigsNamed(name)
 		if err != nil {
 			return fmt.Errorf("cannot autodiscover managed instance groups: %v", err)
 		}
 
 		for _, link := range links {
-			mig, err := m.buildMigFromAutoCfg(link, cfg)
+			migCfg := cfg
+			if cfg.selectsByTemplate() {
+				var matches bool
+				matches, migCfg, err = m.matchMigTemplate(link, spec, cfg, zoneTemplateUrls, mismatches)
+				if err != nil {
+					// Keep the MIG as it is until its template can be checked again.
+					klog.Warningf("Cannot check instance template of autodiscovery candidate %s: %v", link, err)
+					if ref, parseErr := ParseMigUrlRef(link); parseErr == nil {
+						exists[ref] = true
+					}
+					continue
+				}
+				if !matches {
+					continue
+				}
+			}
+			mig, err := m.buildMigFromAutoCfg(link, migCfg)
 			if err != nil {
 				return err
 			}
@@ -491,6 +571,7 @@ func (m *gceManagerImpl) fetchAutoMigs() error {
 			toRegister = append(toRegister, mig)
 		}
 	}
+	m.migTemplateMismatches = mismatches
 
 	workqueue.ParallelizeUntil(context.Background(), m.concurrentGceRefreshes, len(toRegister), func(piece int) {
 		mig := toRegister[piece]
@@ -514,6 +595,125 @@ func (m *gceManagerImpl) fetchAutoMigs() error {
 	return nil
 }
 
+// migTemplateMatchKey identifies an instance template checked against the template
+// selectors of the autodiscovery spec with the given index.
+type migTemplateMatchKey struct {
+	templateUrl string
+	spec        int
+}
+
+// zoneMigTemplateUrls holds the instance template URLs of the MIGs in a zone, by MIG name.
+type zoneMigTemplateUrls struct {
+	urls map[string]string
+	err  error
+}
+
+// matchMigTemplate checks whether the instance template of the MIG behind link matches
+// the template selectors of the spec-th autodiscovery spec cfg. The returned config
+// carries the size bounds of the MIG, taken from the template metadata if set there and
+// from cfg otherwise. Templates found not to match are recorded in mismatches.
+func (m *gceManagerImpl) matchMigTemplate(link string, spec int, cfg migAutoDiscoveryConfig, zoneTemplateUrls map[string]zoneMigTemplateUrls, mismatches map[migTemplateMatchKey]bool) (bool, migAutoDiscoveryConfig, error) {
+	ref, err := ParseMigUrlRef(link)
+	if err != nil {
+		return false, cfg, err
+	}
+	templateUrl, err := m.migTemplateUrl(ref, zoneTemplateUrls)
+	if err != nil {
+		return false, cfg, err
+	}
+	key := migTemplateMatchKey{templateUrl: templateUrl, spec: spec}
+	if m.migTemplateMismatches[key] {
+		mismatches[key] = true
+		return false, cfg, nil
+	}
+
+	var template *gce.InstanceTemplate
+	if _, registered := m.cache.GetMig(ref); registered {
+		template, err = m.migInfoProvider.GetMigInstanceTemplate(ref)
+		if err != nil {
+			return false, cfg, err
+		}
+	} else {
+		templateName, err := InstanceTemplateNameFromUrl(templateUrl)
+		if err != nil {
+			return false, cfg, err
+		}
+		template, err = m.GceService.FetchMigTemplate(ref, templateName.Name, templateName.Regional)
+		if err != nil {
+			return false, cfg, err
+		}
+	}
+	if !cfg.matchesTemplate(template) {
+		mismatches[key] = true
+		return false, cfg, nil
+	}
+	m.cache.SetMigInstanceTemplate(ref, template)
+	if minSize, found, err := getTemplateMetadataInt(template, migTemplateMinNodesKey); err != nil {
+		return false, cfg, err
+	} else if found {
+		cfg.MinSize = minSize
+	}
+	if maxSize, found, err := getTemplateMetadataInt(template, migTemplateMaxNodesKey); err != nil {
+		return false, cfg, err
+	} else if found {
+		cfg.MaxSize = maxSize
+	}
+	if cfg.MaxSize < 1 {
+		return false, cfg, fmt.Errorf("no maximum size set for %s, set %q in the instance template metadata or \"%s\" in the autodiscovery spec", ref, migTemplateMaxNodesKey, migAutoDiscovererKeyMaxNodes)
+	}
+	if cfg.MinSize > cfg.MaxSize {
+		return false, cfg, fmt.Errorf("minimum size %d of %s is larger than its maximum size %d", cfg.MinSize, ref, cfg.MaxSize)
+	}
+	return true, cfg, nil
+}
+
+// migTemplateUrl returns the instance template URL of the referenced MIG. The MIGs of a
+// zone are listed once per autodiscovery run and kept in zoneTemplateUrls, so that
+// checking many MIGs of a zone doesn't take a request per MIG. Regional MIGs are fetched.
+func (m *gceManagerImpl) migTemplateUrl(ref GceRef, zoneTemplateUrls map[string]zoneMigTemplateUrls) (string, error) {
+	if ref.Regional {
+		igm, err := m.GceService.FetchMig(ref)
+		if err != nil {
+			return "", err
+		}
+		return igm.InstanceTemplate, nil
+	}
+	zone, found := zoneTemplateUrls[ref.Zone]
+	if !found {
+		igms, err := m.GceService.FetchAllMigs(ref.Zone)
+		zone = zoneMigTemplateUrls{urls: make(map[string]string, len(igms)), err: err}
+		for _, igm := range igms {
+			zone.urls[igm.Name] = igm.InstanceTemplate
+		}
+		zoneTemplateUrls[ref.Zone] = zone
+	}
+	if zone.err != nil {
+		return "", zone.err
+	}
+	templateUrl := zone.urls[ref.Name]
+	if templateUrl == "" {
+		return "", fmt.Errorf("instance template of %s not found", ref)
+	}
+	return templateUrl, nil
+}
+
+func getTemplateMetadataInt(template *gce.InstanceTemplate, key string) (int, bool, error) {
+	if template.Properties == nil || template.Properties.Metadata == nil {
+		return 0, false, nil
+	}
+	for _, item := range template.Properties.Metadata.Items {
+		if item.Key != key || item.Value == nil {
+			continue
+		}
+		value, err := strconv.Atoi(strings.TrimSpace(*item.Value))
+		if err != nil {
+			return 0, false, fmt.Errorf("invalid %s in metadata of instance template %s: %v", key, template.Name, err)
+		}
+		return value, true, nil
+	}
+	return 0, false, nil
+}
+
 // GetResourceLimiter returns resource limiter from cache.
 func (m *gceManagerImpl) GetResourceLimiter() (*cloudprovider.ResourceLimiter, error) {
 	return m.cache.GetResourceLimiter()
@@ -642,7 +842,47 @@ func (m *gceManagerImpl) GetMigTemplateNode(mig Mig) (*apiv1.Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	return m.templates.BuildNodeFromTemplate(mig, migOsInfo, template, kubeEnv, machineType.CPU, machineType.Memory, nil, m.reserved, m.localSSDDiskSizeProvider)
+	node, err := m.templates.BuildNodeFromTemplate(mig, migOsInfo, template, kubeEnv, machineType.CPU, machineType.Memory, nil, m.reserved, m.localSSDDiskSizeProvider)
+	if err != nil || !mig.GceRef().Regional {
+		return node, err
+	}
+	if err := m.setRegionalMigTemplateZones(mig, node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// setRegionalMigTemplateZones labels a regional MIG template node with the zone the
+// next instance is most likely to be created in. The templates of the other candidate
+// zones are picked by regionalMigNodeGroupListProcessor for pods that can't run there.
+func (m *gceManagerImpl) setRegionalMigTemplateZones(mig Mig, node *apiv1.Node) error {
+	zones, err := m.GetMigTemplateZones(mig)
+	if err != nil {
+		return err
+	}
+	setTemplateZone(node, zones[0])
+	return nil
+}
+
+// GetMigTemplateZones returns the zones a scale-up of a MIG can create instances in,
+// most likely first.
+func (m *gceManagerImpl) GetMigTemplateZones(mig Mig) ([]string, error) {
+	if !mig.GceRef().Regional {
+		return []string{mig.GceRef().Zone}, nil
+	}
+	policy, err := m.migInfoProvider.GetMigDistributionPolicy(mig.GceRef())
+	if err != nil {
+		return nil, err
+	}
+	states, err := m.GetMigZoneInstanceStates(mig)
+	if err != nil {
+		return nil, err
+	}
+	zones := candidateZones(policy.TargetShape, states)
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("no candidate zones found for regional mig %s", mig.GceRef())
+	}
+	return zones, nil
 }
 
 // parseMIGAutoDiscoverySpecs returns any provided NodeGroupAutoDiscoverySpecs
@@ -662,11 +902,45 @@ func parseMIGAutoDiscoverySpecs(o cloudprovider.NodeGroupDiscoveryOptions) ([]mi
 // A migAutoDiscoveryConfig specifies how to autodiscover GCE MIGs.
 type migAutoDiscoveryConfig struct {
 	// Re is a regexp passed using the eq filter to the GCE list API.
+	// Nil if MIGs are selected by their instance templates only.
 	Re *regexp.Regexp
 	// MinSize specifies the minimum size for all MIGs that match Re.
 	MinSize int
 	// MaxSize specifies the maximum size for all MIGs that match Re.
 	MaxSize int
+	// Labels select MIGs whose instance template carries all of the labels.
+	// An empty value matches any value of the label.
+	Labels map[string]string
+	// NetworkTags select MIGs whose instance template carries all of the network tags.
+	NetworkTags []string
+}
+
+func (cfg migAutoDiscoveryConfig) selectsByTemplate() bool {
+	return len(cfg.Labels) > 0 || len(cfg.NetworkTags) > 0
+}
+
+func (cfg migAutoDiscoveryConfig) matchesTemplate(template *gce.InstanceTemplate) bool {
+	if template.Properties == nil {
+		return false
+	}
+	for key, value := range cfg.Labels {
+		templateValue, found := template.Properties.Labels[key]
+		if !found || (value != "" && templateValue != value) {
+			return false
+		}
+	}
+	if len(cfg.NetworkTags) == 0 {
+		return true
+	}
+	if template.Properties.Tags == nil {
+		return false
+	}
+	for _, tag := range cfg.NetworkTags {
+		if !slices.Contains(template.Properties.Tags.Items, tag) {
+			return false
+		}
+	}
+	return true
 }
 
 func parseMIGAutoDiscoverySpec(spec string) (migAutoDiscoveryConfig, error) {
@@ -681,8 +955,9 @@ func parseMIGAutoDiscoverySpec(spec string) (migAutoDiscoveryConfig, error) {
 		return cfg, fmt.Errorf("unsupported discoverer specified: %s", discoverer)
 	}
 
+	maxSizeSet := false
 	for _, arg := range strings.Split(tokens[1], ",") {
-		kv := strings.Split(arg, "=")
+		kv := strings.SplitN(arg, "=", 2)
 		if len(kv) != 2 {
 			return cfg, fmt.Errorf("invalid key=value pair %s", kv)
 		}
@@ -702,13 +977,39 @@ func parseMIGAutoDiscoverySpec(spec string) (migAutoDiscoveryConfig, error) {
 			if cfg.MaxSize, err = strconv.Atoi(v); err != nil {
 				return cfg, fmt.Errorf("invalid maximum nodes: %s", v)
 			}
+			maxSizeSet = true
+		case migAutoDiscovererKeyLabel:
+			// Labels are given as label=<key>=<value>, or label=<key> to match any value.
+			labelKV := strings.SplitN(v, "=", 2)
+			if labelKV[0] == "" {
+				return cfg, fmt.Errorf("invalid template label: %s", v)
+			}
+			if cfg.Labels == nil {
+				cfg.Labels = map[string]string{}
+			}
+			cfg.Labels[labelKV[0]] = ""
+			if len(labelKV) == 2 {
+				cfg.Labels[labelKV[0]] = labelKV[1]
+			}
+		case migAutoDiscovererKeyTag:
+			if v == "" {
+				return cfg, errors.New("empty network tag supplied")
+			}
+			cfg.NetworkTags = append(cfg.NetworkTags, v)
 		default:
 			return cfg, fmt.Errorf("unsupported key \"%s\" is specified for discoverer \"%s\". Supported keys are \"%s\"", k, discoverer, validMIGAutoDiscovererKeys)
 		}
 	}
-	if cfg.Re == nil || cfg.Re.String() == "^.+" {
+	if cfg.Re == nil && !cfg.selectsByTemplate() {
 		return cfg, errors.New("empty instance group name prefix supplied")
 	}
+	if cfg.Re != nil && cfg.Re.String() == "^.+" {
+		return cfg, errors.New("empty instance group name prefix supplied")
+	}
+	// MIGs selected by their templates may take the size bounds from the template metadata.
+	if !maxSizeSet && cfg.selectsByTemplate() {
+		return cfg, nil
+	}
 	if cfg.MinSize > cfg.MaxSize {
 		return cfg, fmt.Errorf("minimum size %d is greater than maximum size %d", cfg.MinSize, cfg.MaxSize)
 	}
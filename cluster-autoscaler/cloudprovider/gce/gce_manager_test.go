@@ -350,6 +350,7 @@ func newTestGceManager(t *testing.T, testServerURL string, regional bool) *gceMa
 		migBaseNameCache:                 map[GceRef]string{},
 		migInstancesStateCountCache:      map[GceRef]map[cloudprovider.InstanceState]int64{},
 		listManagedInstancesResultsCache: map[GceRef]string{},
+		migDistributionPolicyCache:       map[GceRef]MigDistributionPolicy{},
 	}
 	migLister := NewMigLister(cache)
 	manager := &gceManagerImpl{
@@ -1168,6 +1169,136 @@ func TestFetchAutoMigsZonal(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, server)
 }
 
+const labeledInstanceTemplateTemplate = `{
+ "name": "%s",
+ "properties": {
+  "machineType": "n1-standard-1",
+  "labels": {"team": "%s"},
+  "tags": {"items": ["autoscaled"]},
+  "metadata": {
+   "items": [
+    {"key": "cluster-autoscaler-min-nodes", "value": "1"},
+    {"key": "cluster-autoscaler-max-nodes", "value": "%d"}
+   ]
+  }
+ }
+}`
+
+// buildListInstanceGroupManagersResponsePartWithTemplate builds a MIG list entry whose
+// instance template is named after the MIG.
+func buildListInstanceGroupManagersResponsePartWithTemplate(name, zone string, targetSize uint64) string {
+	return strings.ReplaceAll(buildListInstanceGroupManagersResponsePart(name, zone, targetSize),
+		"instanceTemplates/gke-blah-default-pool-67b773a0\"", "instanceTemplates/"+name+"\"")
+}
+
+func TestFetchAutoMigsByTemplate(t *testing.T) {
+	server := NewHttpServerMock()
+	defer server.Close()
+
+	// The MIGs of the zone are listed once per refresh to learn their templates.
+	server.On("handle", "/projects/project1/zones/"+zoneB+"/instanceGroups").Return(buildListInstanceGroupsResponse(zoneB, gceMigA, gceMigB)).Twice()
+	server.On("handle", "/projects/project1/zones/"+zoneB+"/instanceGroupManagers").Return(buildListInstanceGroupManagersResponse(
+		buildListInstanceGroupManagersResponsePartWithTemplate(gceMigA, zoneB, 3),
+		buildListInstanceGroupManagersResponsePartWithTemplate(gceMigB, zoneB, 3),
+	))
+	// Templates are fetched once: the matching one is cached with its MIG, the
+	// other one is remembered not to match.
+	server.On("handle", "/projects/project1/global/instanceTemplates/"+gceMigA).Return(fmt.Sprintf(labeledInstanceTemplateTemplate, gceMigA, "foo", 7)).Once()
+	server.On("handle", "/projects/project1/global/instanceTemplates/"+gceMigB).Return(fmt.Sprintf(labeledInstanceTemplateTemplate, gceMigB, "bar", 5)).Once()
+
+	// Only the matching MIG is registered and has its instances listed.
+	server.On("handle", "/projects/project1/zones/"+zoneB+"/instanceGroupManagers/"+gceMigA+"/listManagedInstances").Return(buildFourRunningInstancesManagedInstancesResponse(zoneB, gceMigA)).Once()
+
+	regional := false
+	g := newTestGceManager(t, server.URL, regional)
+
+	g.migAutoDiscoverySpecs = []migAutoDiscoveryConfig{
+		{Labels: map[string]string{"team": "foo"}, NetworkTags: []string{"autoscaled"}},
+	}
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, g.fetchAutoMigs())
+
+		migs := g.GetMigs()
+		assert.Equal(t, 1, len(migs))
+		validateMigExists(t, migs, zoneB, gceMigA, 1, 7)
+	}
+	mock.AssertExpectationsForObjects(t, server)
+}
+
+func TestFetchAutoMigsByTemplateMinAboveMax(t *testing.T) {
+	server := NewHttpServerMock()
+	defer server.Close()
+
+	server.On("handle", "/projects/project1/zones/"+zoneB+"/instanceGroups").Return(buildListInstanceGroupsResponse(zoneB, gceMigA)).Once()
+	server.On("handle", "/projects/project1/zones/"+zoneB+"/instanceGroupManagers").Return(buildListInstanceGroupManagersResponse(
+		buildListInstanceGroupManagersResponsePartWithTemplate(gceMigA, zoneB, 3),
+	)).Once()
+	template := strings.Replace(fmt.Sprintf(labeledInstanceTemplateTemplate, gceMigA, "foo", 5), `"value": "1"`, `"value": "9"`, 1)
+	server.On("handle", "/projects/project1/global/instanceTemplates/"+gceMigA).Return(template).Once()
+
+	regional := false
+	g := newTestGceManager(t, server.URL, regional)
+
+	g.migAutoDiscoverySpecs = []migAutoDiscoveryConfig{
+		{Labels: map[string]string{"team": "foo"}},
+	}
+
+	assert.NoError(t, g.fetchAutoMigs())
+	assert.Empty(t, g.GetMigs())
+	mock.AssertExpectationsForObjects(t, server)
+}
+
+func TestMigAutoDiscoveryConfigMatchesTemplate(t *testing.T) {
+	template := &gce.InstanceTemplate{
+		Properties: &gce.InstanceProperties{
+			Labels: map[string]string{"team": "foo", "env": "prod"},
+			Tags:   &gce.Tags{Items: []string{"autoscaled", "web"}},
+		},
+	}
+	cases := []struct {
+		name string
+		cfg  migAutoDiscoveryConfig
+		want bool
+	}{
+		{
+			name: "label with value",
+			cfg:  migAutoDiscoveryConfig{Labels: map[string]string{"team": "foo"}},
+			want: true,
+		},
+		{
+			name: "label with any value",
+			cfg:  migAutoDiscoveryConfig{Labels: map[string]string{"env": ""}},
+			want: true,
+		},
+		{
+			name: "label with other value",
+			cfg:  migAutoDiscoveryConfig{Labels: map[string]string{"team": "bar"}},
+			want: false,
+		},
+		{
+			name: "missing label",
+			cfg:  migAutoDiscoveryConfig{Labels: map[string]string{"owner": ""}},
+			want: false,
+		},
+		{
+			name: "labels and tags",
+			cfg:  migAutoDiscoveryConfig{Labels: map[string]string{"team": "foo"}, NetworkTags: []string{"web", "autoscaled"}},
+			want: true,
+		},
+		{
+			name: "missing tag",
+			cfg:  migAutoDiscoveryConfig{NetworkTags: []string{"autoscaled", "db"}},
+			want: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.cfg.matchesTemplate(template))
+		})
+	}
+}
+
 func TestFetchAutoMigsUnregistersMissingMigs(t *testing.T) {
 	server := NewHttpServerMock()
 	defer server.Close()
@@ -1340,9 +1471,9 @@ func TestGetMigTemplateNode(t *testing.T) {
 
 func validateMigExists(t *testing.T, migs []Mig, zone string, name string, minSize int, maxSize int) {
 	ref := GceRef{
-		projectId,
-		zone,
-		name,
+		Project: projectId,
+		Zone:    zone,
+		Name:    name,
 	}
 	for _, mig := range migs {
 		if mig.GceRef() == ref {
@@ -1376,6 +1507,32 @@ func TestParseMIGAutoDiscoverySpecs(t *testing.T) {
 				{Re: regexp.MustCompile("^anotherpfx.+"), MinSize: 1, MaxSize: 2},
 			},
 		},
+		{
+			name: "TemplateSelectors",
+			specs: []string{
+				"mig:label=team=foo,label=env,tag=autoscaled",
+				"mig:namePrefix=pfx,tag=autoscaled,min=1,max=3",
+			},
+			want: []migAutoDiscoveryConfig{
+				{Labels: map[string]string{"team": "foo", "env": ""}, NetworkTags: []string{"autoscaled"}},
+				{Re: regexp.MustCompile("^pfx.+"), NetworkTags: []string{"autoscaled"}, MinSize: 1, MaxSize: 3},
+			},
+		},
+		{
+			name:    "EmptyLabelKey",
+			specs:   []string{"mig:label==foo"},
+			wantErr: true,
+		},
+		{
+			name:    "EmptyTag",
+			specs:   []string{"mig:tag="},
+			wantErr: true,
+		},
+		{
+			name:    "TemplateSelectorsMaxBelowMin",
+			specs:   []string{"mig:tag=autoscaled,min=3,max=1"},
+			wantErr: true,
+		},
 		{
 			name:    "MissingMIGType",
 			specs:   []string{"namePrefix=pfx,min=0,max=10"},
@@ -1548,6 +1705,106 @@ func TestCreateInstancesWithMultipleRequests(t *testing.T) {
 	}
 }
 
+func setupTestRegionalPool(manager *gceManagerImpl, targetShape string, instances []GceInstance) *gceMig {
+	mig := &gceMig{
+		gceRef: GceRef{
+			Name:     "regional-pool",
+			Zone:     region,
+			Project:  projectId,
+			Regional: true,
+		},
+		gceManager: manager,
+		minSize:    0,
+		maxSize:    10,
+	}
+	manager.cache.migs[mig.GceRef()] = mig
+	manager.cache.SetMigTargetSize(mig.GceRef(), int64(len(instances)))
+	manager.cache.SetMigDistributionPolicy(mig.GceRef(), MigDistributionPolicy{
+		Zones:       []string{zoneB, zoneC, zoneF},
+		TargetShape: targetShape,
+	})
+	_ = manager.cache.SetMigInstances(mig.GceRef(), instances, time.Now())
+	return mig
+}
+
+func TestCreateInstancesRegionalMig(t *testing.T) {
+	stockout := &cloudprovider.InstanceErrorInfo{
+		ErrorClass: cloudprovider.OutOfResourcesErrorClass,
+		ErrorCode:  ErrorCodeResourcePoolExhausted,
+	}
+	instances := []GceInstance{
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-b/regional-pool-a", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning}}},
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-c/regional-pool-b", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceCreating, ErrorInfo: stockout}}},
+	}
+
+	t.Run("resizes the mig", func(t *testing.T) {
+		server := NewHttpServerMock()
+		defer server.Close()
+		g := newTestGceManager(t, server.URL, true)
+		mig := setupTestRegionalPool(g, TargetShapeBalanced, instances)
+
+		server.On("handle", "/projects/project1/regions/us-central1/instanceGroupManagers/regional-pool/resize").Return(setMigSizeResponse).Once()
+		server.On("handle", "/projects/project1/regions/us-central1/operations/operation-1505739408819-5597646964339-eb839c88-28805931/wait").Return(setMigSizeOperationResponse).Once()
+		err := g.CreateInstances(mig, 3)
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, server)
+
+		size, err := g.GetMigSize(mig)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), size)
+	})
+
+	t.Run("even target shape with stockout", func(t *testing.T) {
+		server := NewHttpServerMock()
+		defer server.Close()
+		g := newTestGceManager(t, server.URL, true)
+		mig := setupTestRegionalPool(g, TargetShapeEven, instances)
+
+		err := g.CreateInstances(mig, 3)
+		assert.ErrorContains(t, err, "zones us-central1-c are out of resources")
+		mock.AssertExpectationsForObjects(t, server)
+	})
+}
+
+func TestGetMigZoneInstanceStates(t *testing.T) {
+	server := NewHttpServerMock()
+	defer server.Close()
+	g := newTestGceManager(t, server.URL, true)
+	mig := setupTestRegionalPool(g, TargetShapeEven, []GceInstance{
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-b/regional-pool-a", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning}}},
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-b/regional-pool-b", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceDeleting}}},
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-f/regional-pool-c", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceCreating}}},
+	})
+
+	states, err := g.GetMigZoneInstanceStates(mig)
+	assert.NoError(t, err)
+	assert.Equal(t, []ZoneInstanceState{
+		{Zone: zoneB, Running: 1, Deleting: 1},
+		{Zone: zoneC},
+		{Zone: zoneF, Creating: 1},
+	}, states)
+}
+
+func TestGetMigTemplateZones(t *testing.T) {
+	server := NewHttpServerMock()
+	defer server.Close()
+	g := newTestGceManager(t, server.URL, true)
+	mig := setupTestRegionalPool(g, TargetShapeEven, []GceInstance{
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-b/regional-pool-a", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning}}},
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-f/regional-pool-b", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceRunning}}},
+		{Instance: cloudprovider.Instance{Id: "gce://project1/us-central1-f/regional-pool-c", Status: &cloudprovider.InstanceStatus{State: cloudprovider.InstanceCreating}}},
+	})
+
+	zones, err := g.GetMigTemplateZones(mig)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{zoneC, zoneB, zoneF}, zones)
+
+	zonal := &gceMig{gceRef: GceRef{Name: "zonal-pool", Zone: zoneB, Project: projectId}}
+	zones, err = g.GetMigTemplateZones(zonal)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{zoneB}, zones)
+}
+
 func TestGetMigOptions(t *testing.T) {
 	defaultOptions := &config.NodeGroupAutoscalingOptions{
 		ScaleDownUtilizationThreshold:    0.1,
@@ -32,8 +32,10 @@ const (
 var (
 	regionalInstanceTemplateRe = regexp.MustCompile("(/projects/.*[A-Za-z0-9]+.*/regions/)")
 	migUrlRe                   = regexp.MustCompile(anyHttpsUrlPattern + "projects/(.*)/zones/(.*)/instanceGroups/(.*)")
+	regionalMigUrlRe           = regexp.MustCompile(anyHttpsUrlPattern + "projects/(.*)/regions/(.*)/instanceGroups/(.*)")
 	igmUrlRe                   = regexp.MustCompile(anyHttpsUrlPattern + "projects/(.*)/zones/(.*)/instanceGroupManagers/(.*)")
 	igmRefUrlRe                = regexp.MustCompile("projects/(.*)/zones/(.*)/instanceGroupManagers/(.*)")
+	regionalIgmRefUrlRe        = regexp.MustCompile("projects/(.*)/regions/(.*)/instanceGroupManagers/(.*)")
 	instanceUrlRe              = regexp.MustCompile(anyHttpsUrlPattern + "projects/(.*)/zones/(.*)/instances/(.*)")
 )
 
@@ -43,6 +45,26 @@ func ParseMigUrl(url string) (project string, zone string, name string, err erro
 	return parseGceUrl(url, migUrlRe, anyHttpsUrlPattern, "instanceGroups")
 }
 
+// ParseMigUrlRef expects url in format:
+// https://.*/projects/<project-id>/zones/<zone>/instanceGroups/<name>
+// or, for regional MIGs:
+// https://.*/projects/<project-id>/regions/<region>/instanceGroups/<name>
+// and returns a GceRef struct for it.
+func ParseMigUrlRef(url string) (GceRef, error) {
+	if regionalMigUrlRe.MatchString(url) {
+		project, region, name, err := parseGceUrl(url, regionalMigUrlRe, anyHttpsUrlPattern, "instanceGroups")
+		if err != nil {
+			return GceRef{}, err
+		}
+		return GceRef{Project: project, Zone: region, Name: name, Regional: true}, nil
+	}
+	project, zone, name, err := ParseMigUrl(url)
+	if err != nil {
+		return GceRef{}, err
+	}
+	return GceRef{Project: project, Zone: zone, Name: name}, nil
+}
+
 // ParseIgmUrl expects url in format:
 // https://.*/<project-id>/zones/<zone>/instanceGroupManagers/<name>
 func ParseIgmUrl(url string) (project string, zone string, name string, err error) {
@@ -51,8 +73,17 @@ func ParseIgmUrl(url string) (project string, zone string, name string, err erro
 
 // ParseIgmUrlRef expects url in format:
 // projects/<project-id>/zones/<zone>/instanceGroupManagers/<name>
+// or, for regional MIGs:
+// projects/<project-id>/regions/<region>/instanceGroupManagers/<name>
 // and returns a GceRef struct for it.
 func ParseIgmUrlRef(url string) (GceRef, error) {
+	if regionalIgmRefUrlRe.MatchString(url) {
+		project, region, name, err := parseGceUrl(url, regionalIgmRefUrlRe, "", "instanceGroupManagers")
+		if err != nil {
+			return GceRef{}, err
+		}
+		return GceRef{Project: project, Zone: region, Name: name, Regional: true}, nil
+	}
 	project, zone, name, err := parseGceUrl(url, igmRefUrlRe, "", "instanceGroupManagers")
 	if err != nil {
 		return GceRef{}, err
@@ -93,11 +124,14 @@ func GenerateInstanceUrl(domainUrl string, ref GceRef) string {
 	return domainUrl + projectsSubstring + ref.Project + "/zones/" + ref.Zone + "/instances/" + ref.Name
 }
 
-// GenerateMigUrl generates url for instance.
+// GenerateMigUrl generates url for mig.
 func GenerateMigUrl(domainUrl string, ref GceRef) string {
 	if domainUrl == "" {
 		domainUrl = defaultDomainUrl
 	}
+	if ref.Regional {
+		return domainUrl + projectsSubstring + ref.Project + "/regions/" + ref.Zone + "/instanceGroups/" + ref.Name
+	}
 	return domainUrl + projectsSubstring + ref.Project + "/zones/" + ref.Zone + "/instanceGroups/" + ref.Name
 }
 
@@ -83,6 +83,16 @@ func TestGenerateMigUrl(t *testing.T) {
 			},
 			want: "https://www.googleapis.com/compute-custom/v2/projects/proj1/zones/us-central1-a/instanceGroups/name1",
 		},
+		{
+			name: "regional",
+			ref: GceRef{
+				Project:  "proj1",
+				Name:     "name1",
+				Zone:     "us-central1",
+				Regional: true,
+			},
+			want: "https://www.googleapis.com/compute/v1/projects/proj1/regions/us-central1/instanceGroups/name1",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -159,6 +169,16 @@ func TestParseIgmUrlRef(t *testing.T) {
 				Zone:    "us-central1-a",
 			},
 		},
+		{
+			name: "regional",
+			url:  "projects/proj1/regions/us-central1/instanceGroupManagers/name1",
+			want: GceRef{
+				Project:  "proj1",
+				Name:     "name1",
+				Zone:     "us-central1",
+				Regional: true,
+			},
+		},
 		{
 			name:    "incorrect domain",
 			url:     "https://www.googleapis.com/compute_test/v1/projects2/proj1/zones/us-central1-a/instanceGroupManagers2/name1",
@@ -307,6 +327,51 @@ func TestParseMigUrl(t *testing.T) {
 	}
 }
 
+func TestParseMigUrlRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    GceRef
+		wantErr error
+	}{
+		{
+			name: "zonal",
+			url:  "https://www.googleapis.com/compute/v1/projects/proj1/zones/us-central1-a/instanceGroups/name1",
+			want: GceRef{
+				Project: "proj1",
+				Name:    "name1",
+				Zone:    "us-central1-a",
+			},
+		},
+		{
+			name: "regional",
+			url:  "https://www.googleapis.com/compute/v1/projects/proj1/regions/us-central1/instanceGroups/name1",
+			want: GceRef{
+				Project:  "proj1",
+				Name:     "name1",
+				Zone:     "us-central1",
+				Regional: true,
+			},
+		},
+		{
+			name:    "incorrect domain",
+			url:     "https://www.googleapis.com/compute_test/v1/projects2/proj1/zones/us-central1-a/instanceGroups/name1",
+			wantErr: fmt.Errorf("wrong url: expected format https://.*/projects/<project-id>/zones/<zone>/instanceGroups/<name>, got https://www.googleapis.com/compute_test/v1/projects2/proj1/zones/us-central1-a/instanceGroups/name1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMigUrlRef(tt.url)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equalf(t, tt.want, got, "ParseMigUrlRef(%v)", tt.url)
+			assert.Equal(t, tt.url, GenerateMigUrl("", got))
+		})
+	}
+}
+
 func TestIsInstanceTemplateRegional(t *testing.T) {
 	tests := []struct {
 		name           string
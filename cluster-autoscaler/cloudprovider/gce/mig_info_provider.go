@@ -56,6 +56,9 @@ type MigInfoProvider interface {
 	GetMigMachineType(migRef GceRef) (MachineType, error)
 	// Returns the pagination behavior of the listManagedInstances Results API method for a given MIG ref
 	GetListManagedInstancesResults(migRef GceRef) (string, error)
+	// GetMigDistributionPolicy returns the zones and target shape used by a MIG.
+	// For zonal MIGs the policy consists of the MIG's zone only.
+	GetMigDistributionPolicy(migRef GceRef) (MigDistributionPolicy, error)
 	// GetMigIsStable returns whether given MIG is stable. A stable state means that: none of the instances in the managed instance group is currently undergoing any type of change (for example, creation, restart, or deletion); no future changes are scheduled for instances in the managed instance group; and the managed instance group itself is not being modified.
 	GetMigIsStable(migRef GceRef) (bool, error)
 	// RefreshMigInfo updates the cached information for a specific MIG without rebuilding the full zone cache
@@ -279,6 +282,14 @@ func (c *cachingMigInfoProvider) updateMigInstancesCache(migToInstances map[GceR
 	var errors []error
 	for _, mig := range c.migLister.GetMigs() {
 		migRef := mig.GceRef()
+		// Regional MIGs are not covered by listing instances zone by zone,
+		// igm.ListInstances is used for them instead.
+		if migRef.Regional {
+			if err := c.fillMigInstances(migRef); err != nil {
+				errors = append(errors, err)
+			}
+			continue
+		}
 		// If there is an inconsistency between number of instances according to instances.List
 		// and number of instances according to migInstancesStateCount for the given mig, which can be due to
 		// - abandoned instance
@@ -318,7 +329,7 @@ func (c *cachingMigInfoProvider) findMigWithMatchingBasename(instanceRef GceRef)
 	for _, mig := range c.migLister.GetMigs() {
 		migRef := mig.GceRef()
 		basename, err := c.GetMigBasename(migRef)
-		if err == nil && migRef.Project == instanceRef.Project && migRef.Zone == instanceRef.Zone && strings.HasPrefix(instanceRef.Name, basename) {
+		if err == nil && migRef.Project == instanceRef.Project && migRef.ContainsZone(instanceRef.Zone) && strings.HasPrefix(instanceRef.Name, basename) {
 			return mig
 		}
 	}
@@ -523,9 +534,9 @@ func (c *cachingMigInfoProvider) fillMigInfoCache() error {
 				}
 			}
 			zoneMigRef := GceRef{
-				projectId,
-				zone,
-				zoneMig.Name,
+				Project: projectId,
+				Zone:    zone,
+				Name:    zoneMig.Name,
 			}
 
 			if registeredMigRefs[zoneMigRef] {
@@ -563,6 +574,9 @@ func (c *cachingMigInfoProvider) setMigInfoCache(migRef GceRef, mig *gce.Instanc
 	}
 	c.cache.SetListManagedInstancesResults(migRef, mig.ListManagedInstancesResults)
 	c.cache.SetMigInstancesStateCount(migRef, createInstancesStateCount(mig.TargetSize, mig.CurrentActions))
+	if migRef.Regional {
+		c.cache.SetMigDistributionPolicy(migRef, newMigDistributionPolicy(mig.DistributionPolicy))
+	}
 
 	_, templateName := path.Split(mig.InstanceTemplate)
 	regional := IsInstanceTemplateRegional(mig.InstanceTemplate)
@@ -619,6 +633,10 @@ func (c *cachingMigInfoProvider) getRegisteredMigRefs() map[GceRef]bool {
 func (c *cachingMigInfoProvider) listAllZonesWithMigs() map[string]bool {
 	zones := map[string]bool{}
 	for _, mig := range c.migLister.GetMigs() {
+		// Regional MIGs can't be listed per zone, they are fetched one by one.
+		if mig.GceRef().Regional {
+			continue
+		}
 		zones[mig.GceRef().Zone] = true
 	}
 	return zones
@@ -634,6 +652,17 @@ func (c *cachingMigInfoProvider) GetMigMachineType(migRef GceRef) (MachineType,
 		return NewCustomMachineType(machineName)
 	}
 	zone := migRef.Zone
+	if migRef.Regional {
+		// Machine types are zonal resources, look it up in any of the MIG's zones.
+		policy, err := c.GetMigDistributionPolicy(migRef)
+		if err != nil {
+			return MachineType{}, err
+		}
+		if len(policy.Zones) == 0 {
+			return MachineType{}, fmt.Errorf("no zones found in distribution policy of %v", migRef)
+		}
+		zone = policy.Zones[0]
+	}
 	machine, found := c.cache.GetMachine(machineName, zone)
 	if !found {
 		rawMachine, err := c.gceClient.FetchMachineType(zone, machineName)
@@ -677,6 +706,30 @@ func (c *cachingMigInfoProvider) GetListManagedInstancesResults(migRef GceRef) (
 	return listManagedInstancesResults, nil
 }
 
+func (c *cachingMigInfoProvider) GetMigDistributionPolicy(migRef GceRef) (MigDistributionPolicy, error) {
+	if !migRef.Regional {
+		return MigDistributionPolicy{Zones: []string{migRef.Zone}}, nil
+	}
+
+	c.migInfoMutex.Lock()
+	defer c.migInfoMutex.Unlock()
+
+	policy, found := c.cache.GetMigDistributionPolicy(migRef)
+	if found {
+		return policy, nil
+	}
+
+	err := c.fillSingleMigInfo(migRef)
+	if err != nil {
+		return MigDistributionPolicy{}, err
+	}
+	policy, found = c.cache.GetMigDistributionPolicy(migRef)
+	if !found {
+		return MigDistributionPolicy{}, fmt.Errorf("distribution policy for %v not found in cache after refresh", migRef)
+	}
+	return policy, nil
+}
+
 func createInstancesStateCount(targetSize int64, actionsSummary *gce.InstanceGroupManagerActionsSummary) map[cloudprovider.InstanceState]int64 {
 	if actionsSummary == nil {
 		return nil
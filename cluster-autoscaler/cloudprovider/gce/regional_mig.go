@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	gce "google.golang.org/api/compute/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+)
+
+const (
+	// TargetShapeEven schedules instances so that their count differs by at most one between zones.
+	TargetShapeEven = "EVEN"
+	// TargetShapeBalanced prioritizes obtainable capacity while spreading instances as evenly as possible.
+	TargetShapeBalanced = "BALANCED"
+	// TargetShapeAny picks zones based on available capacity only.
+	TargetShapeAny = "ANY"
+	// TargetShapeAnySingleZone keeps all instances in a single zone.
+	TargetShapeAnySingleZone = "ANY_SINGLE_ZONE"
+)
+
+// MigDistributionPolicy describes how a MIG spreads its instances across zones.
+type MigDistributionPolicy struct {
+	// Zones lists the zones instances can be created in, in the order returned by GCE.
+	Zones []string
+	// TargetShape is one of the TargetShape* constants. Empty for zonal MIGs.
+	TargetShape string
+}
+
+// ZoneInstanceState summarizes instances of a MIG within a single zone.
+type ZoneInstanceState struct {
+	Zone     string
+	Running  int64
+	Creating int64
+	Deleting int64
+	// OutOfResources is set if any instance being created in the zone failed
+	// with an error of cloudprovider.OutOfResourcesErrorClass.
+	OutOfResources bool
+	// ErrorInfo holds the last out of resources error seen in the zone.
+	ErrorInfo *cloudprovider.InstanceErrorInfo
+}
+
+// Region returns the region the referenced entity lives in.
+func (ref GceRef) Region() string {
+	if ref.Regional {
+		return ref.Zone
+	}
+	if i := strings.LastIndex(ref.Zone, "-"); i > 0 {
+		return ref.Zone[:i]
+	}
+	return ref.Zone
+}
+
+// ContainsZone returns whether instances of the referenced MIG can live in the given zone.
+func (ref GceRef) ContainsZone(zone string) bool {
+	if ref.Regional {
+		return strings.HasPrefix(zone, ref.Zone+"-")
+	}
+	return ref.Zone == zone
+}
+
+func newMigDistributionPolicy(policy *gce.DistributionPolicy) MigDistributionPolicy {
+	result := MigDistributionPolicy{}
+	if policy == nil {
+		return result
+	}
+	result.TargetShape = policy.TargetShape
+	for _, zone := range policy.Zones {
+		if zone == nil || zone.Zone == "" {
+			continue
+		}
+		result.Zones = append(result.Zones, path.Base(zone.Zone))
+	}
+	return result
+}
+
+// zoneInstanceStates groups the MIG instances by zone. Zones from the policy come first,
+// in policy order, followed by zones only known from the instances, sorted by name.
+func zoneInstanceStates(policy MigDistributionPolicy, instances []GceInstance) []ZoneInstanceState {
+	states := make([]ZoneInstanceState, 0, len(policy.Zones))
+	index := make(map[string]int, len(policy.Zones))
+	for _, zone := range policy.Zones {
+		index[zone] = len(states)
+		states = append(states, ZoneInstanceState{Zone: zone})
+	}
+	var extra []string
+	for _, instance := range instances {
+		ref, err := GceRefFromProviderId(instance.Id)
+		if err != nil {
+			continue
+		}
+		if _, found := index[ref.Zone]; !found {
+			index[ref.Zone] = -1
+			extra = append(extra, ref.Zone)
+		}
+	}
+	sort.Strings(extra)
+	for _, zone := range extra {
+		index[zone] = len(states)
+		states = append(states, ZoneInstanceState{Zone: zone})
+	}
+
+	for _, instance := range instances {
+		ref, err := GceRefFromProviderId(instance.Id)
+		if err != nil {
+			continue
+		}
+		state := &states[index[ref.Zone]]
+		if instance.Status == nil {
+			state.Running++
+			continue
+		}
+		switch instance.Status.State {
+		case cloudprovider.InstanceCreating:
+			state.Creating++
+		case cloudprovider.InstanceDeleting:
+			state.Deleting++
+		default:
+			state.Running++
+		}
+		if errorInfo := instance.Status.ErrorInfo; errorInfo != nil && errorInfo.ErrorClass == cloudprovider.OutOfResourcesErrorClass {
+			state.OutOfResources = true
+			state.ErrorInfo = errorInfo
+		}
+	}
+	return states
+}
+
+// outOfResourcesZones returns the zones in which instance creation is failing with stockouts.
+func outOfResourcesZones(states []ZoneInstanceState) []string {
+	var zones []string
+	for _, state := range states {
+		if state.OutOfResources {
+			zones = append(zones, state.Zone)
+		}
+	}
+	return zones
+}
+
+// candidateZones orders the zones in which a scale-up of a MIG with the given target
+// shape is expected to land, most likely first. Zones that are out of resources always
+// come last. For ANY_SINGLE_ZONE the zone already holding instances is preferred, for
+// other shapes the zones with the fewest instances are.
+func candidateZones(targetShape string, states []ZoneInstanceState) []string {
+	ordered := make([]ZoneInstanceState, len(states))
+	copy(ordered, states)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].OutOfResources != ordered[j].OutOfResources {
+			return !ordered[i].OutOfResources
+		}
+		countI := ordered[i].Running + ordered[i].Creating
+		countJ := ordered[j].Running + ordered[j].Creating
+		if targetShape == TargetShapeAnySingleZone {
+			return countI > countJ
+		}
+		return countI < countJ
+	})
+	zones := make([]string, 0, len(ordered))
+	for _, state := range ordered {
+		zones = append(zones, state.Zone)
+	}
+	return zones
+}
+
+// setTemplateZone labels a template node with the zone its instance is created in.
+func setTemplateZone(node *apiv1.Node, zone string) {
+	node.Labels[apiv1.LabelTopologyZone] = zone
+	node.Labels[gceCSITopologyKeyZone] = zone
+}
+
+// bestTemplateZone returns the candidate zone whose template node matches the
+// required node affinity of the most pods. Ties go to the most likely zone.
+func bestTemplateZone(node *apiv1.Node, zones []string, pods []*apiv1.Pod) string {
+	best, bestCount := "", -1
+	for _, zone := range zones {
+		zoneNode := node.DeepCopy()
+		setTemplateZone(zoneNode, zone)
+		count := 0
+		for _, pod := range pods {
+			if match, err := nodeaffinity.GetRequiredNodeAffinity(pod).Match(zoneNode); err == nil && match {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = zone, count
+		}
+	}
+	return best
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce
+
+import (
+	"maps"
+
+	apiv1 "k8s.io/api/core/v1"
+	klog "k8s.io/klog/v2"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+	"sigs.k8s.io/cluster-autoscaler/pkg/context"
+	"sigs.k8s.io/cluster-autoscaler/pkg/processors/nodegroups"
+	"sigs.k8s.io/cluster-autoscaler/pkg/simulator/framework"
+)
+
+// regionalMigNodeGroupListProcessor gives each regional MIG considered in a
+// scale-up the template of its candidate zone that suits the unschedulable pods
+// best. A MIG has a single template, labeled with the zone its next instance is
+// most likely created in, so pods that can only run in one of its other zones
+// would otherwise not be helped by scaling it up.
+type regionalMigNodeGroupListProcessor struct {
+	nodegroups.NodeGroupListProcessor
+	manager GceManager
+}
+
+// NewRegionalMigNodeGroupListProcessor wraps a NodeGroupListProcessor to pick the
+// template zone of regional MIGs.
+func NewRegionalMigNodeGroupListProcessor(processor nodegroups.NodeGroupListProcessor, manager GceManager) nodegroups.NodeGroupListProcessor {
+	return &regionalMigNodeGroupListProcessor{
+		NodeGroupListProcessor: processor,
+		manager:                manager,
+	}
+}
+
+// Process replaces the templates of regional MIGs with the ones of their best candidate zone.
+func (p *regionalMigNodeGroupListProcessor) Process(autoscalingCtx *context.AutoscalingContext, nodeGroups []cloudprovider.NodeGroup,
+	nodeInfos map[string]*framework.NodeInfo, unschedulablePods []*apiv1.Pod) ([]cloudprovider.NodeGroup, map[string]*framework.NodeInfo, error) {
+	nodeGroups, nodeInfos, err := p.NodeGroupListProcessor.Process(autoscalingCtx, nodeGroups, nodeInfos, unschedulablePods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The templates are cached across loops, only the map of this loop is updated.
+	cloned := false
+	for _, nodeGroup := range nodeGroups {
+		mig, ok := nodeGroup.(*gceMig)
+		if !ok || !mig.GceRef().Regional {
+			continue
+		}
+		nodeInfo, found := nodeInfos[mig.Id()]
+		if !found {
+			continue
+		}
+		zones, err := p.manager.GetMigTemplateZones(mig)
+		if err != nil {
+			klog.Warningf("Failed to get candidate zones of regional mig %s, keeping its template: %v", mig.GceRef(), err)
+			continue
+		}
+		zone := bestTemplateZone(nodeInfo.Node(), zones, unschedulablePods)
+		if zone == nodeInfo.Node().Labels[apiv1.LabelTopologyZone] {
+			continue
+		}
+
+		zoneNodeInfo := nodeInfo.DeepCopy()
+		setTemplateZone(zoneNodeInfo.Node(), zone)
+		if !cloned {
+			nodeInfos = maps.Clone(nodeInfos)
+			cloned = true
+		}
+		nodeInfos[mig.Id()] = zoneNodeInfo
+		klog.V(4).Infof("Using the template of zone %s for regional mig %s", zone, mig.GceRef())
+	}
+	return nodeGroups, nodeInfos, nil
+}
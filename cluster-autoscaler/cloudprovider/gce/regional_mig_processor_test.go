@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+	"sigs.k8s.io/cluster-autoscaler/pkg/context"
+	"sigs.k8s.io/cluster-autoscaler/pkg/simulator/framework"
+)
+
+type passThroughNodeGroupListProcessor struct{}
+
+func (passThroughNodeGroupListProcessor) Process(_ *context.AutoscalingContext, nodeGroups []cloudprovider.NodeGroup,
+	nodeInfos map[string]*framework.NodeInfo, _ []*apiv1.Pod) ([]cloudprovider.NodeGroup, map[string]*framework.NodeInfo, error) {
+	return nodeGroups, nodeInfos, nil
+}
+
+func (passThroughNodeGroupListProcessor) CleanUp() {}
+
+func zoneTemplateNodeInfo(name, zone string) *framework.NodeInfo {
+	node := &apiv1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+	setTemplateZone(node, zone)
+	return framework.NewNodeInfo(node, nil)
+}
+
+func TestRegionalMigNodeGroupListProcessor(t *testing.T) {
+	gceManagerMock := &gceManagerMock{}
+	regional := &gceMig{
+		gceRef:     GceRef{Project: "project1", Zone: "us-central1", Name: "regional-pool", Regional: true},
+		gceManager: gceManagerMock,
+	}
+	zonal := &gceMig{
+		gceRef:     GceRef{Project: "project1", Zone: "us-central1-b", Name: "zonal-pool"},
+		gceManager: gceManagerMock,
+	}
+	gceManagerMock.On("GetMigTemplateZones", regional).Return([]string{"us-central1-b", "us-central1-c"}, nil)
+
+	regionalTemplate := zoneTemplateNodeInfo("regional-template", "us-central1-b")
+	zonalTemplate := zoneTemplateNodeInfo("zonal-template", "us-central1-b")
+	nodeInfos := map[string]*framework.NodeInfo{
+		regional.Id(): regionalTemplate,
+		zonal.Id():    zonalTemplate,
+	}
+	processor := NewRegionalMigNodeGroupListProcessor(passThroughNodeGroupListProcessor{}, gceManagerMock)
+
+	// Pods fitting the most likely zone keep the template.
+	_, got, err := processor.Process(nil, []cloudprovider.NodeGroup{regional, zonal}, nodeInfos, []*apiv1.Pod{zonePod("p1")})
+	assert.NoError(t, err)
+	assert.Same(t, regionalTemplate, got[regional.Id()])
+	assert.Same(t, zonalTemplate, got[zonal.Id()])
+
+	// Pods that can only run in another candidate zone get the template of that zone.
+	_, got, err = processor.Process(nil, []cloudprovider.NodeGroup{regional, zonal}, nodeInfos, []*apiv1.Pod{zonePod("p1", "us-central1-c")})
+	assert.NoError(t, err)
+	assert.Equal(t, "us-central1-c", got[regional.Id()].Node().Labels[apiv1.LabelTopologyZone])
+	assert.Equal(t, "us-central1-c", got[regional.Id()].Node().Labels[gceCSITopologyKeyZone])
+	assert.Same(t, zonalTemplate, got[zonal.Id()])
+
+	// The templates passed in are left untouched.
+	assert.Same(t, regionalTemplate, nodeInfos[regional.Id()])
+	assert.Equal(t, "us-central1-b", regionalTemplate.Node().Labels[apiv1.LabelTopologyZone])
+	gceManagerMock.AssertExpectations(t)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gce "google.golang.org/api/compute/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGceRefRegion(t *testing.T) {
+	zonal := GceRef{Project: "proj1", Zone: "us-central1-b", Name: "mig"}
+	regional := GceRef{Project: "proj1", Zone: "us-central1", Name: "mig", Regional: true}
+
+	assert.Equal(t, "us-central1", zonal.Region())
+	assert.Equal(t, "us-central1", regional.Region())
+
+	assert.True(t, zonal.ContainsZone("us-central1-b"))
+	assert.False(t, zonal.ContainsZone("us-central1-c"))
+	assert.True(t, regional.ContainsZone("us-central1-c"))
+	assert.False(t, regional.ContainsZone("us-central2-a"))
+}
+
+func TestNewMigDistributionPolicy(t *testing.T) {
+	assert.Equal(t, MigDistributionPolicy{}, newMigDistributionPolicy(nil))
+	assert.Equal(t, MigDistributionPolicy{
+		Zones:       []string{"us-central1-a", "us-central1-c"},
+		TargetShape: TargetShapeBalanced,
+	}, newMigDistributionPolicy(&gce.DistributionPolicy{
+		TargetShape: TargetShapeBalanced,
+		Zones: []*gce.DistributionPolicyZoneConfiguration{
+			{Zone: "https://www.googleapis.com/compute/v1/projects/proj1/zones/us-central1-a"},
+			nil,
+			{Zone: "zones/us-central1-c"},
+		},
+	}))
+}
+
+func TestCandidateZones(t *testing.T) {
+	states := []ZoneInstanceState{
+		{Zone: "us-central1-a", Running: 3},
+		{Zone: "us-central1-b", Running: 1, OutOfResources: true},
+		{Zone: "us-central1-c", Running: 1, Creating: 1},
+		{Zone: "us-central1-f", Running: 2},
+	}
+	testCases := []struct {
+		name        string
+		targetShape string
+		want        []string
+	}{
+		{
+			name:        "even",
+			targetShape: TargetShapeEven,
+			want:        []string{"us-central1-c", "us-central1-f", "us-central1-a", "us-central1-b"},
+		},
+		{
+			name:        "balanced",
+			targetShape: TargetShapeBalanced,
+			want:        []string{"us-central1-c", "us-central1-f", "us-central1-a", "us-central1-b"},
+		},
+		{
+			name:        "any single zone",
+			targetShape: TargetShapeAnySingleZone,
+			want:        []string{"us-central1-a", "us-central1-c", "us-central1-f", "us-central1-b"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, candidateZones(tc.targetShape, states))
+		})
+	}
+}
+
+func zonePod(name string, zones ...string) *apiv1.Pod {
+	pod := &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if len(zones) == 0 {
+		return pod
+	}
+	pod.Spec.Affinity = &apiv1.Affinity{
+		NodeAffinity: &apiv1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &apiv1.NodeSelector{
+				NodeSelectorTerms: []apiv1.NodeSelectorTerm{{
+					MatchExpressions: []apiv1.NodeSelectorRequirement{{
+						Key:      apiv1.LabelTopologyZone,
+						Operator: apiv1.NodeSelectorOpIn,
+						Values:   zones,
+					}},
+				}},
+			},
+		},
+	}
+	return pod
+}
+
+func TestBestTemplateZone(t *testing.T) {
+	node := &apiv1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{}}}
+	zones := []string{"us-central1-b", "us-central1-c", "us-central1-f"}
+
+	testCases := []struct {
+		name string
+		pods []*apiv1.Pod
+		want string
+	}{
+		{
+			name: "no pods",
+			want: "us-central1-b",
+		},
+		{
+			name: "pods without zone constraints",
+			pods: []*apiv1.Pod{zonePod("p1"), zonePod("p2")},
+			want: "us-central1-b",
+		},
+		{
+			name: "pods pinned to another candidate zone",
+			pods: []*apiv1.Pod{zonePod("p1"), zonePod("p2", "us-central1-f")},
+			want: "us-central1-f",
+		},
+		{
+			name: "zone fitting the most pods",
+			pods: []*apiv1.Pod{zonePod("p1", "us-central1-c"), zonePod("p2", "us-central1-f"), zonePod("p3", "us-central1-f", "us-central1-c"), zonePod("p4", "us-central1-f")},
+			want: "us-central1-f",
+		},
+		{
+			name: "pods pinned to a zone that is not a candidate",
+			pods: []*apiv1.Pod{zonePod("p1", "us-central1-a")},
+			want: "us-central1-b",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, bestTemplateZone(node, zones, tc.pods))
+		})
+	}
+	assert.Empty(t, node.Labels)
+}
@@ -339,6 +339,13 @@ func BuildGenericLabels(ref GceRef, machineType string, nodeName string, os Oper
 	result[apiv1.LabelOSStable] = string(os)
 
 	result[apiv1.LabelInstanceTypeStable] = machineType
+	result[apiv1.LabelHostname] = nodeName
+	if ref.Regional {
+		// The zone of a regional MIG instance is only known once it's created,
+		// zone labels are filled in by the caller based on the distribution policy.
+		result[apiv1.LabelTopologyRegion] = ref.Zone
+		return result, nil
+	}
 	ix := strings.LastIndex(ref.Zone, "-")
 	if ix == -1 {
 		return nil, fmt.Errorf("unexpected zone: %s", ref.Zone)
@@ -346,7 +353,6 @@ func BuildGenericLabels(ref GceRef, machineType string, nodeName string, os Oper
 	result[apiv1.LabelTopologyRegion] = ref.Zone[:ix]
 	result[apiv1.LabelTopologyZone] = ref.Zone
 	result[gceCSITopologyKeyZone] = ref.Zone
-	result[apiv1.LabelHostname] = nodeName
 	return result, nil
 }
 
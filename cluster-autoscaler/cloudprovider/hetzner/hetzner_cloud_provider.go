@@ -195,6 +195,12 @@ func (d *HetznerCloudProvider) Cleanup() error {
 func (d *HetznerCloudProvider) Refresh() error {
 	for _, group := range d.manager.nodeGroups {
 		group.resetTargetSize(0)
+
+		if group.placementGroupShards != nil {
+			if err := group.placementGroupShards.cleanup(d.manager.apiCallContext); err != nil {
+				klog.Warningf("failed to clean up placement groups of node group %s: %v", group.id, err)
+			}
+		}
 	}
 	return nil
 }
@@ -229,6 +235,7 @@ func BuildHetzner(_ *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDi
 	validNodePoolName := regexp.MustCompile(`^[a-z0-9A-Z]+[a-z0-9A-Z\-\.\_]*[a-z0-9A-Z]+$|^[a-z0-9A-Z]{1}$`)
 	clusterUpdateLock := sync.Mutex{}
 	placementGroupTotals := make(map[string]int)
+	var networkZones map[string]hcloud.NetworkZone
 	for _, nodegroupSpec := range do.NodeGroupSpecs {
 		spec, err := createNodePoolSpec(nodegroupSpec)
 		if err != nil {
@@ -236,14 +243,12 @@ func BuildHetzner(_ *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDi
 		}
 
 		validNodePoolName.MatchString(spec.name)
-		servers, err := manager.allServers(spec.name)
-		if err != nil {
-			klog.Fatalf("Failed to get servers for for node pool %s error: %v", nodegroupSpec, err)
-		}
 
 		var placementGroup *hcloud.PlacementGroup
+		var placementGroupShards *placementGroupShards
 		var subnetIPRange *net.IPNet
 		var poolFirewalls []*hcloud.Firewall
+		var extraLocations, fallbackInstanceTypes []string
 		if manager.clusterConfig.IsUsingNewFormat {
 			_, ok := manager.clusterConfig.NodeConfigs[spec.name]
 			if !ok {
@@ -252,7 +257,9 @@ func BuildHetzner(_ *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDi
 
 			placementGroupRef := manager.clusterConfig.NodeConfigs[spec.name].PlacementGroup
 
-			if placementGroupRef != "" {
+			if manager.clusterConfig.NodeConfigs[spec.name].PlacementGroupSharding {
+				placementGroupShards = newPlacementGroupShards(manager, spec.name, placementGroupRef)
+			} else if placementGroupRef != "" {
 				placementGroup, err = getPlacementGroup(manager, placementGroupRef)
 				if err != nil {
 					klog.Fatalf("Encountered error while fetching placement group: %v", err)
@@ -288,20 +295,52 @@ func BuildHetzner(_ *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDi
 				}
 			}
 
+			extraLocations = manager.clusterConfig.NodeConfigs[spec.name].Locations
+			if len(extraLocations) > 0 {
+				if networkZones == nil {
+					networkZones, err = getNetworkZones(manager)
+					if err != nil {
+						klog.Fatalf("Encountered error while fetching locations: %v", err)
+					}
+				}
+				if err := checkSameNetworkZone(networkZones, spec.region, extraLocations); err != nil {
+					klog.Fatalf("Invalid locations for node group %s: %v", spec.name, err)
+				}
+			}
+			fallbackInstanceTypes = manager.clusterConfig.NodeConfigs[spec.name].FallbackServerTypes
+		}
+
+		base := hetznerNodeGroup{
+			manager:              manager,
+			id:                   spec.name,
+			minSize:              spec.minSize,
+			maxSize:              spec.maxSize,
+			instanceType:         strings.ToLower(spec.instanceType),
+			region:               strings.ToLower(spec.region),
+			clusterUpdateMutex:   &clusterUpdateLock,
+			placementGroup:       placementGroup,
+			placementGroupShards: placementGroupShards,
+			subnetIPRange:        subnetIPRange,
+			firewalls:            buildServerCreateFirewalls(manager.firewall, poolFirewalls),
+		}
+		nodeGroups := []*hetznerNodeGroup{&base}
+		if len(extraLocations) > 0 || len(fallbackInstanceTypes) > 0 {
+			pool := newHetznerNodePool(base,
+				uniqueLowerStrings(append([]string{spec.instanceType}, fallbackInstanceTypes...)),
+				uniqueLowerStrings(append([]string{spec.region}, extraLocations...)))
+			nodeGroups = pool.nodeGroups
 		}
 
-		manager.nodeGroups[spec.name] = &hetznerNodeGroup{
-			manager:            manager,
-			id:                 spec.name,
-			minSize:            spec.minSize,
-			maxSize:            spec.maxSize,
-			instanceType:       strings.ToLower(spec.instanceType),
-			region:             strings.ToLower(spec.region),
-			targetSize:         len(servers),
-			clusterUpdateMutex: &clusterUpdateLock,
-			placementGroup:     placementGroup,
-			subnetIPRange:      subnetIPRange,
-			firewalls:          buildServerCreateFirewalls(manager.firewall, poolFirewalls),
+		for _, nodeGroup := range nodeGroups {
+			if _, found := manager.nodeGroups[nodeGroup.id]; found {
+				klog.Fatalf("Node group %s of node pool %s is defined twice", nodeGroup.id, spec.name)
+			}
+			servers, err := manager.allServers(nodeGroup.id)
+			if err != nil {
+				klog.Fatalf("Failed to get servers for for node group %s error: %v", nodeGroup.id, err)
+			}
+			nodeGroup.targetSize = len(servers)
+			manager.nodeGroups[nodeGroup.id] = nodeGroup
 		}
 	}
 
@@ -361,6 +400,46 @@ func getFirewall(manager *hetznerManager, firewallRef string) (*hcloud.Firewall,
 	return firewall, nil
 }
 
+// getNetworkZones returns the network zone of every location, by location name.
+func getNetworkZones(manager *hetznerManager) (map[string]hcloud.NetworkZone, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	locations, err := manager.client.Location.All(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("Timed out listing locations.")
+		}
+		return nil, fmt.Errorf("Failed to list locations. Error: %w", err)
+	}
+
+	networkZones := make(map[string]hcloud.NetworkZone, len(locations))
+	for _, location := range locations {
+		networkZones[location.Name] = location.NetworkZone
+	}
+	return networkZones, nil
+}
+
+// checkSameNetworkZone checks that all locations are in the network zone of the
+// given region, so servers created in any of them can join the same network.
+func checkSameNetworkZone(networkZones map[string]hcloud.NetworkZone, region string, locations []string) error {
+	region = strings.ToLower(region)
+	zone, found := networkZones[region]
+	if !found {
+		return fmt.Errorf("unknown location %s", region)
+	}
+	for _, location := range uniqueLowerStrings(locations) {
+		locationZone, found := networkZones[location]
+		if !found {
+			return fmt.Errorf("unknown location %s", location)
+		}
+		if locationZone != zone {
+			return fmt.Errorf("location %s is in network zone %s, not in network zone %s of location %s", location, locationZone, zone, region)
+		}
+	}
+	return nil
+}
+
 func createNodePoolSpec(groupSpec string) (*hetznerNodeGroupSpec, error) {
 	tokens := strings.SplitN(groupSpec, ":", 5)
 	if len(tokens) != 5 {
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/hetzner/hcloud-go/hcloud"
+)
+
+func TestCheckSameNetworkZone(t *testing.T) {
+	networkZones := map[string]hcloud.NetworkZone{
+		"fsn1": hcloud.NetworkZoneEUCentral,
+		"nbg1": hcloud.NetworkZoneEUCentral,
+		"hel1": hcloud.NetworkZoneEUCentral,
+		"ash":  hcloud.NetworkZoneUSEast,
+	}
+
+	cases := []struct {
+		name      string
+		region    string
+		locations []string
+		wantErr   bool
+	}{
+		{name: "same network zone", region: "fsn1", locations: []string{"nbg1", "HEL1"}},
+		{name: "region repeated", region: "FSN1", locations: []string{"fsn1"}},
+		{name: "other network zone", region: "fsn1", locations: []string{"nbg1", "ash"}, wantErr: true},
+		{name: "unknown location", region: "fsn1", locations: []string{"xyz1"}, wantErr: true},
+		{name: "unknown region", region: "xyz1", locations: []string{"fsn1"}, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSameNetworkZone(networkZones, tc.region, tc.locations)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -84,6 +84,16 @@ type NodeConfig struct {
 	// Firewalls are additional firewall ids or names attached to this nodepool's
 	// servers, on top of the cluster-wide HCLOUD_FIREWALL.
 	Firewalls []string
+	// Locations are additional locations servers are created in, in order, when
+	// the node group's own location runs out of capacity.
+	Locations []string
+	// FallbackServerTypes are tried in order when the node group's server type
+	// can't be created in any of its locations.
+	FallbackServerTypes []string
+	// PlacementGroupSharding spreads the nodepool's servers over as many spread
+	// placement groups as needed, named PlacementGroup-<index> (or
+	// <pool>-<index> if PlacementGroup is empty), instead of a single existing one.
+	PlacementGroupSharding bool
 }
 
 // LegacyConfig holds the configuration in the legacy format
@@ -98,14 +108,24 @@ func newManager() (*hetznerManager, error) {
 		return nil, errors.New("`HCLOUD_TOKEN` is not specified")
 	}
 
+	apiHTTPClient := httpClient
+	if requestLogPath := os.Getenv("HCLOUD_REQUEST_LOG"); requestLogPath != "" {
+		requestLog, err := openRequestLog(requestLogPath)
+		if err != nil {
+			return nil, err
+		}
+		apiHTTPClient = &http.Client{
+			Transport: requestLog.roundTripper(httpClient.Transport),
+		}
+	}
+
 	opts := []hcloud.ClientOption{
 		hcloud.WithToken(token),
-		hcloud.WithHTTPClient(httpClient),
+		hcloud.WithHTTPClient(apiHTTPClient),
 		hcloud.WithApplication("cluster-autoscaler", version.ClusterAutoscalerVersion),
 		hcloud.WithPollOpts(hcloud.PollOpts{
 			BackoffFunc: hcloud.ExponentialBackoff(2, 500*time.Millisecond),
 		}),
-		hcloud.WithDebugWriter(&debugWriter{}),
 	}
 
 	endpoint := os.Getenv("HCLOUD_ENDPOINT")
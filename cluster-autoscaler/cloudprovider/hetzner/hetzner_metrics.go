@@ -17,6 +17,8 @@ limitations under the License.
 package hetzner
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -32,6 +34,16 @@ import (
 
 const subsystemIdentifier = "api"
 
+// Error classes of failed hcloud API requests.
+const (
+	errorClassTimeout     = "timeout"
+	errorClassCanceled    = "canceled"
+	errorClassTransport   = "transport"
+	errorClassRateLimited = "rate_limited"
+	errorClassClient      = "client"
+	errorClassServer      = "server"
+)
+
 func instrumentedRoundTripper() http.RoundTripper {
 	inFlightRequestsGauge := k8smetrics.NewGauge(&k8smetrics.GaugeOpts{
 		Name: fmt.Sprintf("hcloud_%s_in_flight_requests", subsystemIdentifier),
@@ -55,14 +67,46 @@ func instrumentedRoundTripper() http.RoundTripper {
 		[]string{"method"},
 	)
 
+	endpointLatencyHistogram := k8smetrics.NewHistogramVec(
+		&k8smetrics.HistogramOpts{
+			Name:    fmt.Sprintf("hcloud_%s_endpoint_request_duration_seconds", subsystemIdentifier),
+			Help:    fmt.Sprintf("A histogram of request latencies to the hcloud %s per endpoint.", subsystemIdentifier),
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "api_endpoint"},
+	)
+
+	errorsCounter := k8smetrics.NewCounterVec(
+		&k8smetrics.CounterOpts{
+			Name: fmt.Sprintf("hcloud_%s_errors_total", subsystemIdentifier),
+			Help: fmt.Sprintf("A counter for failed requests to the hcloud %s per endpoint and error class.", subsystemIdentifier),
+		},
+		[]string{"method", "api_endpoint", "class"},
+	)
+
+	rateLimitGauge := k8smetrics.NewGaugeVec(
+		&k8smetrics.GaugeOpts{
+			Name: fmt.Sprintf("hcloud_%s_rate_limit", subsystemIdentifier),
+			Help: fmt.Sprintf("The rate limit of the hcloud %s as reported by the last response, by kind (limit, remaining or reset_seconds).", subsystemIdentifier),
+		},
+		[]string{"kind"},
+	)
+
 	legacyregistry.MustRegister(requestsPerEndpointCounter)
 	legacyregistry.MustRegister(requestLatencyHistogram)
+	legacyregistry.MustRegister(endpointLatencyHistogram)
 	legacyregistry.MustRegister(inFlightRequestsGauge)
+	legacyregistry.MustRegister(errorsCounter)
+	legacyregistry.MustRegister(rateLimitGauge)
 
 	return instrumentRoundTripperInFlight(inFlightRequestsGauge,
-		instrumentRoundTripperDuration(requestLatencyHistogram,
+		instrumentRoundTripperDuration(requestLatencyHistogram, endpointLatencyHistogram,
 			instrumentRoundTripperEndpoint(requestsPerEndpointCounter,
-				http.DefaultTransport,
+				instrumentRoundTripperErrors(errorsCounter,
+					instrumentRoundTripperRateLimit(rateLimitGauge,
+						http.DefaultTransport,
+					),
+				),
 			),
 		),
 	)
@@ -83,12 +127,15 @@ func instrumentRoundTripperInFlight(gauge *k8smetrics.Gauge, next http.RoundTrip
 	})
 }
 
-func instrumentRoundTripperDuration(obs *k8smetrics.HistogramVec, next http.RoundTripper) roundTripperFunc {
+func instrumentRoundTripperDuration(obs, endpointObs *k8smetrics.HistogramVec, next http.RoundTripper) roundTripperFunc {
 	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
 		start := time.Now()
 		resp, err := next.RoundTrip(r)
 		if err == nil {
-			obs.WithLabelValues(strings.ToLower(resp.Request.Method)).Observe(time.Since(start).Seconds())
+			duration := time.Since(start).Seconds()
+			method := strings.ToLower(resp.Request.Method)
+			obs.WithLabelValues(method).Observe(duration)
+			endpointObs.WithLabelValues(method, preparePathForLabel(resp.Request.URL.Path)).Observe(duration)
 		}
 		return resp, err
 	})
@@ -105,6 +152,59 @@ func instrumentRoundTripperEndpoint(counter *k8smetrics.CounterVec, next http.Ro
 	}
 }
 
+func instrumentRoundTripperErrors(counter *k8smetrics.CounterVec, next http.RoundTripper) roundTripperFunc {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		resp, err := next.RoundTrip(r)
+		if class := classifyError(resp, err); class != "" {
+			counter.WithLabelValues(strings.ToLower(r.Method), preparePathForLabel(r.URL.Path), class).Inc()
+		}
+		return resp, err
+	})
+}
+
+func instrumentRoundTripperRateLimit(gauge *k8smetrics.GaugeVec, next http.RoundTripper) roundTripperFunc {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		resp, err := next.RoundTrip(r)
+		if err == nil {
+			if limit, err := strconv.Atoi(resp.Header.Get("RateLimit-Limit")); err == nil {
+				gauge.WithLabelValues("limit").Set(float64(limit))
+			}
+			if remaining, err := strconv.Atoi(resp.Header.Get("RateLimit-Remaining")); err == nil {
+				gauge.WithLabelValues("remaining").Set(float64(remaining))
+			}
+			if reset, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64); err == nil {
+				gauge.WithLabelValues("reset_seconds").Set(max(time.Until(time.Unix(reset, 0)).Seconds(), 0))
+			}
+		}
+		return resp, err
+	})
+}
+
+// classifyError returns the error class of a request, or an empty string if it
+// succeeded.
+func classifyError(resp *http.Response, err error) string {
+	if err != nil {
+		var timeoutErr interface{ Timeout() bool }
+		switch {
+		case errors.Is(err, context.DeadlineExceeded), errors.As(err, &timeoutErr) && timeoutErr.Timeout():
+			return errorClassTimeout
+		case errors.Is(err, context.Canceled):
+			return errorClassCanceled
+		default:
+			return errorClassTransport
+		}
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return errorClassRateLimited
+	case resp.StatusCode >= 500:
+		return errorClassServer
+	case resp.StatusCode >= 400:
+		return errorClassClient
+	}
+	return ""
+}
+
 func preparePathForLabel(path string) string {
 	path = strings.ToLower(path)
 
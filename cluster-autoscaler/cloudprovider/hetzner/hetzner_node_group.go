@@ -47,11 +47,17 @@ type hetznerNodeGroup struct {
 	targetSize   int
 	region       string
 	instanceType string
+	// pool is the nodepool the node group creates servers for, it is nil if
+	// the nodepool only has the node group.
+	pool *hetznerNodePool
 
 	clusterUpdateMutex *sync.Mutex
 	placementGroup     *hcloud.PlacementGroup
-	subnetIPRange      *net.IPNet
-	firewalls          []*hcloud.ServerCreateFirewall
+	// placementGroupShards is set instead of placementGroup if the node group
+	// spreads its servers over multiple placement groups.
+	placementGroupShards *placementGroupShards
+	subnetIPRange        *net.IPNet
+	firewalls            []*hcloud.ServerCreateFirewall
 }
 
 type hetznerNodeGroupSpec struct {
@@ -62,9 +68,86 @@ type hetznerNodeGroupSpec struct {
 	instanceType string
 }
 
+// hetznerNodePool holds the node groups of a nodepool spanning several
+// server types and locations, one per combination. They share the max size of
+// the nodepool.
+type hetznerNodePool struct {
+	name    string
+	maxSize int
+	// nodeGroups are the node groups of the nodepool, most preferred first.
+	nodeGroups []*hetznerNodeGroup
+}
+
+// newHetznerNodePool creates the node groups of a nodepool, one per server type
+// and location, most preferred first. All locations are used for a server type
+// before falling back to the next one, so the nodepool keeps its shape as long
+// as possible. The first node group keeps the name and min size of the
+// nodepool, the others are named <pool>-<server type>-<location>.
+func newHetznerNodePool(base hetznerNodeGroup, serverTypes, locations []string) *hetznerNodePool {
+	pool := &hetznerNodePool{name: base.id, maxSize: base.maxSize}
+	for _, serverType := range serverTypes {
+		for _, location := range locations {
+			nodeGroup := base
+			nodeGroup.pool = pool
+			nodeGroup.instanceType = serverType
+			nodeGroup.region = location
+			if len(pool.nodeGroups) > 0 {
+				nodeGroup.id = fmt.Sprintf("%s-%s-%s", base.id, serverType, location)
+				nodeGroup.minSize = 0
+			}
+			pool.nodeGroups = append(pool.nodeGroups, &nodeGroup)
+		}
+	}
+	return pool
+}
+
+// maxSizeOf returns the max size of a node group of the nodepool. A node group
+// only grows once servers can't be created with the more preferred ones, up to
+// the size left by the other node groups.
+func (p *hetznerNodePool) maxSizeOf(n *hetznerNodeGroup) int {
+	others := 0
+	preferred := true
+	for _, nodeGroup := range p.nodeGroups {
+		if nodeGroup == n {
+			preferred = false
+			continue
+		}
+		others += nodeGroup.targetSize
+		if preferred && nodeGroup.canCreateServers() {
+			return n.targetSize
+		}
+	}
+	if !n.canCreateServers() {
+		return n.targetSize
+	}
+	return max(n.targetSize, p.maxSize-others)
+}
+
+// canCreateServers returns whether the server type of the node group is offered
+// in its location and wasn't recently found to be unavailable there.
+func (n *hetznerNodeGroup) canCreateServers() bool {
+	available, err := serverTypeAvailable(n.manager, n.instanceType, n.region)
+	if err != nil {
+		klog.Warningf("failed to check if server type %s is available in %s: %v", n.instanceType, n.region, err)
+		return false
+	}
+	return available && !n.manager.cachedServerType.isUnavailable(n.instanceType, n.region)
+}
+
+// nodeConfigName returns the name of the node config of the node group.
+func (n *hetznerNodeGroup) nodeConfigName() string {
+	if n.pool == nil {
+		return n.id
+	}
+	return n.pool.name
+}
+
 // MaxSize returns maximum size of the node group.
 func (n *hetznerNodeGroup) MaxSize() int {
-	return n.maxSize
+	if n.pool == nil {
+		return n.maxSize
+	}
+	return n.pool.maxSizeOf(n)
 }
 
 // MinSize returns minimum size of the node group.
@@ -107,14 +190,6 @@ func (n *hetznerNodeGroup) IncreaseSize(delta int) error {
 	n.clusterUpdateMutex.Lock()
 	defer n.clusterUpdateMutex.Unlock()
 
-	available, err := serverTypeAvailable(n.manager, n.instanceType, n.region)
-	if err != nil {
-		return fmt.Errorf("failed to check if type %s is available in region %s error: %v", n.instanceType, n.region, err)
-	}
-	if !available {
-		return fmt.Errorf("server type %s not available in region %s", n.instanceType, n.region)
-	}
-
 	defer func() {
 		// create new servers cache
 		if _, err := n.manager.cachedServers.servers(); err != nil {
@@ -129,7 +204,8 @@ func (n *hetznerNodeGroup) IncreaseSize(delta int) error {
 	// server manually. This operation might fail for some of the servers
 	// because of quotas, rate limiting or server type availability. We need to
 	// collect the errors and inform cluster-autoscaler about this, so it can
-	// try other node groups if configured.
+	// try other node groups if configured, such as the next ones of the
+	// nodepool.
 	waitGroup := sync.WaitGroup{}
 	errsCh := make(chan error, delta)
 	for i := 0; i < delta; i++ {
@@ -147,7 +223,7 @@ func (n *hetznerNodeGroup) IncreaseSize(delta int) error {
 	close(errsCh)
 
 	errs := make([]error, 0, delta)
-	for err = range errsCh {
+	for err := range errsCh {
 		errs = append(errs, err)
 	}
 	if len(errs) > 0 {
@@ -297,7 +373,7 @@ func (n *hetznerNodeGroup) TemplateNodeInfo() (*framework.NodeInfo, error) {
 	node.Labels = cloudprovider.JoinStringMaps(node.Labels, nodeGroupLabels)
 
 	if n.manager.clusterConfig.IsUsingNewFormat {
-		for _, taint := range n.manager.clusterConfig.NodeConfigs[n.id].Taints {
+		for _, taint := range n.manager.clusterConfig.NodeConfigs[n.nodeConfigName()].Taints {
 			node.Spec.Taints = append(node.Spec.Taints, apiv1.Taint{
 				Key:    taint.Key,
 				Value:  taint.Value,
@@ -379,6 +455,22 @@ func toInstanceStatus(status hcloud.ServerStatus) *cloudprovider.InstanceStatus
 	return st
 }
 
+// uniqueLowerStrings lower-cases the given values and drops empty ones and
+// duplicates, keeping the order of first occurrence.
+func uniqueLowerStrings(values []string) []string {
+	var result []string
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
+
 func newNodeName(n *hetznerNodeGroup) string {
 	return fmt.Sprintf("%s-%x", n.id, rand.Int63())
 }
@@ -401,7 +493,7 @@ func buildNodeGroupLabels(n *hetznerNodeGroup) (map[string]string, error) {
 	}
 
 	if n.manager.clusterConfig.IsUsingNewFormat {
-		maps.Copy(labels, n.manager.clusterConfig.NodeConfigs[n.id].Labels)
+		maps.Copy(labels, n.manager.clusterConfig.NodeConfigs[n.nodeConfigName()].Labels)
 	}
 
 	klog.V(4).Infof("%s nodegroup labels: %s", n.id, labels)
@@ -493,7 +585,7 @@ func createServer(n *hetznerNodeGroup) error {
 	cloudInit := n.manager.clusterConfig.LegacyConfig.CloudInit
 
 	if n.manager.clusterConfig.IsUsingNewFormat {
-		cloudInit = n.manager.clusterConfig.NodeConfigs[n.id].CloudInit
+		cloudInit = n.manager.clusterConfig.NodeConfigs[n.nodeConfigName()].CloudInit
 	}
 
 	// dont start the server if we need to attach the server to a private subnet network
@@ -501,7 +593,7 @@ func createServer(n *hetznerNodeGroup) error {
 
 	serverLabels := make(map[string]string)
 	if n.manager.clusterConfig.IsUsingNewFormat {
-		maps.Copy(serverLabels, n.manager.clusterConfig.NodeConfigs[n.id].ServerLabels)
+		maps.Copy(serverLabels, n.manager.clusterConfig.NodeConfigs[n.nodeConfigName()].ServerLabels)
 	}
 	serverLabels[nodeGroupLabel] = n.id
 
@@ -526,10 +618,22 @@ func createServer(n *hetznerNodeGroup) error {
 		opts.Networks = []*hcloud.Network{n.manager.network}
 	}
 	opts.Firewalls = n.firewalls
+	if n.placementGroupShards != nil {
+		shard, err := n.placementGroupShards.acquire(ctx)
+		if err != nil {
+			return err
+		}
+		defer n.placementGroupShards.release(shard)
+		opts.PlacementGroup = shard
+	}
 
 	serverCreateResult, _, err := n.manager.client.Server.Create(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("could not create server type %s in region %s: %v", n.instanceType, n.region, err)
+		// Let the following node groups of the nodepool take over for a while.
+		if hcloud.IsError(err, hcloud.ErrorCodeResourceUnavailable, hcloud.ErrorCodePlacementError) {
+			n.manager.cachedServerType.markUnavailable(n.instanceType, n.region)
+		}
+		return fmt.Errorf("could not create server type %s in region %s: %w", n.instanceType, n.region, err)
 	}
 
 	server := serverCreateResult.Server
@@ -586,7 +690,7 @@ func findImage(n *hetznerNodeGroup, serverType *hcloud.ServerType) (*hcloud.Imag
 	if n.manager.clusterConfig.IsUsingNewFormat {
 		// Check for nodepool-specific images first, then fall back to global images
 		var imagesForArch *ImageList
-		if nodeConfig, exists := n.manager.clusterConfig.NodeConfigs[n.id]; exists && nodeConfig.ImagesForArch != nil {
+		if nodeConfig, exists := n.manager.clusterConfig.NodeConfigs[n.nodeConfigName()]; exists && nodeConfig.ImagesForArch != nil {
 			imagesForArch = nodeConfig.ImagesForArch
 		} else {
 			imagesForArch = &n.manager.clusterConfig.ImagesForArch
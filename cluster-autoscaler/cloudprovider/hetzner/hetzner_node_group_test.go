@@ -17,6 +17,7 @@ limitations under the License.
 package hetzner
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -206,3 +207,73 @@ func TestImageSelectionLogic(t *testing.T) {
 		assert.Equal(t, "global-amd64-image", imagesForArch.Amd64)
 	})
 }
+
+func TestHetznerNodePool(t *testing.T) {
+	priced := func(name string, locations ...string) *hcloud.ServerType {
+		serverType := &hcloud.ServerType{Name: name}
+		for _, location := range locations {
+			serverType.Pricings = append(serverType.Pricings, hcloud.ServerTypeLocationPricing{
+				Location: &hcloud.Location{Name: location},
+			})
+		}
+		return serverType
+	}
+
+	cachedServerType := newServerTypeCache(context.Background(), nil)
+	require.NoError(t, cachedServerType.Add(serverTypeCachedObject{
+		name: serverTypeCacheKey,
+		serverTypes: []*hcloud.ServerType{
+			priced("cx22", "fsn1", "nbg1"),
+			priced("cx32", "fsn1", "hel1"),
+		},
+	}))
+	pool := newHetznerNodePool(hetznerNodeGroup{
+		id:      "pool1",
+		manager: &hetznerManager{cachedServerType: cachedServerType},
+		minSize: 1,
+		maxSize: 10,
+	}, []string{"cx22", "cx32"}, []string{"fsn1", "nbg1", "hel1"})
+
+	type nodeGroup struct {
+		id, instanceType, region string
+		minSize                  int
+	}
+	var nodeGroups []nodeGroup
+	for _, n := range pool.nodeGroups {
+		assert.Same(t, pool, n.pool)
+		assert.Equal(t, "pool1", n.nodeConfigName())
+		nodeGroups = append(nodeGroups, nodeGroup{n.id, n.instanceType, n.region, n.minSize})
+	}
+	assert.Equal(t, []nodeGroup{
+		{"pool1", "cx22", "fsn1", 1},
+		{"pool1-cx22-nbg1", "cx22", "nbg1", 0},
+		{"pool1-cx22-hel1", "cx22", "hel1", 0},
+		{"pool1-cx32-fsn1", "cx32", "fsn1", 0},
+		{"pool1-cx32-nbg1", "cx32", "nbg1", 0},
+		{"pool1-cx32-hel1", "cx32", "hel1", 0},
+	}, nodeGroups)
+
+	maxSizes := func() []int {
+		var sizes []int
+		for _, n := range pool.nodeGroups {
+			sizes = append(sizes, n.MaxSize())
+		}
+		return sizes
+	}
+	pool.nodeGroups[0].targetSize = 3
+	pool.nodeGroups[3].targetSize = 2
+
+	// Only the first node group can grow, the others keep their size.
+	assert.Equal(t, []int{8, 0, 0, 2, 0, 0}, maxSizes())
+
+	// cx22 isn't offered in hel1, so the node groups after it only grow once
+	// cx22 is unavailable in both fsn1 and nbg1.
+	cachedServerType.markUnavailable("cx22", "fsn1")
+	assert.Equal(t, []int{3, 5, 0, 2, 0, 0}, maxSizes())
+	cachedServerType.markUnavailable("cx22", "nbg1")
+	assert.Equal(t, []int{3, 0, 0, 7, 0, 0}, maxSizes())
+	cachedServerType.markUnavailable("cx32", "fsn1")
+	assert.Equal(t, []int{3, 0, 0, 2, 0, 5}, maxSizes())
+
+	assert.Equal(t, []string{"fsn1", "nbg1"}, uniqueLowerStrings([]string{"FSN1", "", "nbg1", "fsn1"}))
+}
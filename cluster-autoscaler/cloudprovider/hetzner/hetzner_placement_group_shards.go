@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/hetzner/hcloud-go/hcloud"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// placementGroupShardLabel holds the index of a placement group shard.
+	placementGroupShardLabel = hcloudLabelNamespace + "/placement-group-shard"
+	// placementGroupCleanupInterval is the minimum time between two cleanups
+	// of empty placement group shards.
+	placementGroupCleanupInterval = 5 * time.Minute
+)
+
+// placementGroupShards manages the spread placement groups of a node group that
+// may grow beyond the size of a single placement group. Shards are named
+// <prefix>-<index> and labelled with the node group, so they can be found
+// again after a restart. New shards are created when all existing ones are
+// full and empty shards are deleted by cleanup.
+type placementGroupShards struct {
+	manager   *hetznerManager
+	nodeGroup string
+	prefix    string
+
+	// changeMutex serializes creating and deleting shards. Unlike mutex, it
+	// is held across API calls.
+	changeMutex sync.Mutex
+
+	mutex sync.Mutex
+	// reserved counts servers being created per shard id, which are not yet
+	// part of the placement group's server list.
+	reserved map[int64]int
+	// deleting holds the ids of shards being deleted by cleanup.
+	deleting map[int64]bool
+	// generation is increased whenever a reservation is released or a shard
+	// is deleted, so that shard lists taken before can be detected as stale.
+	generation  uint64
+	lastCleanup time.Time
+}
+
+func newPlacementGroupShards(manager *hetznerManager, nodeGroup, prefix string) *placementGroupShards {
+	if prefix == "" {
+		prefix = nodeGroup
+	}
+	return &placementGroupShards{
+		manager:   manager,
+		nodeGroup: nodeGroup,
+		prefix:    prefix,
+		reserved:  make(map[int64]int),
+		deleting:  make(map[int64]bool),
+	}
+}
+
+// acquire returns a shard with room for one more server, creating a new one if
+// all shards are full. The returned shard must be released once the server is
+// created or its creation failed.
+func (s *placementGroupShards) acquire(ctx context.Context) (*hcloud.PlacementGroup, error) {
+	_, shard, err := s.listAndReserve(ctx)
+	if err != nil || shard != nil {
+		return shard, err
+	}
+
+	s.changeMutex.Lock()
+	defer s.changeMutex.Unlock()
+
+	// Another acquire may have created a shard in the meantime.
+	shards, shard, err := s.listAndReserve(ctx)
+	if err != nil || shard != nil {
+		return shard, err
+	}
+
+	index := nextPlacementGroupShardIndex(shards)
+	result, _, err := s.manager.client.PlacementGroup.Create(ctx, hcloud.PlacementGroupCreateOpts{
+		Name: fmt.Sprintf("%s-%d", s.prefix, index),
+		Labels: map[string]string{
+			nodeGroupLabel:           s.nodeGroup,
+			placementGroupShardLabel: strconv.Itoa(index),
+		},
+		Type: hcloud.PlacementGroupTypeSpread,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create placement group shard %d for node group %s: %w", index, s.nodeGroup, err)
+	}
+	klog.Infof("Created placement group %s for node group %s", result.PlacementGroup.Name, s.nodeGroup)
+
+	// The shard is reserved before changeMutex is unlocked, so cleanup does
+	// not see it empty and unreserved.
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.reserved[result.PlacementGroup.ID]++
+	return result.PlacementGroup, nil
+}
+
+// listAndReserve lists the shards and reserves the one with room for one more
+// server, if any. The list is taken again if it got stale while being fetched.
+func (s *placementGroupShards) listAndReserve(ctx context.Context) ([]*hcloud.PlacementGroup, *hcloud.PlacementGroup, error) {
+	for {
+		s.mutex.Lock()
+		generation := s.generation
+		s.mutex.Unlock()
+
+		shards, err := s.list(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		s.mutex.Lock()
+		if s.generation != generation {
+			s.mutex.Unlock()
+			continue
+		}
+		available := make([]*hcloud.PlacementGroup, 0, len(shards))
+		for _, shard := range shards {
+			if !s.deleting[shard.ID] {
+				available = append(available, shard)
+			}
+		}
+		shard := pickPlacementGroupShard(available, s.reserved)
+		if shard != nil {
+			s.reserved[shard.ID]++
+		}
+		s.mutex.Unlock()
+		return shards, shard, nil
+	}
+}
+
+// release frees the reservation taken by acquire.
+func (s *placementGroupShards) release(shard *hcloud.PlacementGroup) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.reserved[shard.ID]--
+	if s.reserved[shard.ID] <= 0 {
+		delete(s.reserved, shard.ID)
+	}
+	s.generation++
+}
+
+// cleanup deletes shards without servers. It does nothing if the last cleanup
+// happened less than placementGroupCleanupInterval ago.
+func (s *placementGroupShards) cleanup(ctx context.Context) error {
+	s.mutex.Lock()
+	if time.Since(s.lastCleanup) < placementGroupCleanupInterval {
+		s.mutex.Unlock()
+		return nil
+	}
+	s.lastCleanup = time.Now()
+	generation := s.generation
+	s.mutex.Unlock()
+
+	s.changeMutex.Lock()
+	defer s.changeMutex.Unlock()
+
+	shards, err := s.list(ctx)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if len(shard.Servers) > 0 {
+			continue
+		}
+
+		s.mutex.Lock()
+		if s.generation != generation {
+			// A server may have been added to the shard since it was listed,
+			// the next cleanup will take another look.
+			s.mutex.Unlock()
+			return nil
+		}
+		if s.reserved[shard.ID] > 0 {
+			s.mutex.Unlock()
+			continue
+		}
+		s.deleting[shard.ID] = true
+		s.mutex.Unlock()
+
+		_, err := s.manager.client.PlacementGroup.Delete(ctx, shard)
+
+		s.mutex.Lock()
+		delete(s.deleting, shard.ID)
+		s.generation++
+		generation = s.generation
+		s.mutex.Unlock()
+
+		if err != nil {
+			return fmt.Errorf("failed to delete empty placement group %s: %w", shard.Name, err)
+		}
+		klog.Infof("Deleted empty placement group %s of node group %s", shard.Name, s.nodeGroup)
+	}
+	return nil
+}
+
+func (s *placementGroupShards) list(ctx context.Context) ([]*hcloud.PlacementGroup, error) {
+	shards, err := s.manager.client.PlacementGroup.AllWithOpts(ctx, hcloud.PlacementGroupListOpts{
+		ListOpts: hcloud.ListOpts{
+			LabelSelector: fmt.Sprintf("%s=%s,%s", nodeGroupLabel, s.nodeGroup, placementGroupShardLabel),
+		},
+		Type: hcloud.PlacementGroupTypeSpread,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list placement groups of node group %s: %w", s.nodeGroup, err)
+	}
+	return shards, nil
+}
+
+// pickPlacementGroupShard returns the shard with the lowest index that has room
+// for another server, or nil if all shards are full.
+func pickPlacementGroupShard(shards []*hcloud.PlacementGroup, reserved map[int64]int) *hcloud.PlacementGroup {
+	var picked *hcloud.PlacementGroup
+	pickedIndex := -1
+	for _, shard := range shards {
+		if len(shard.Servers)+reserved[shard.ID] >= maxPlacementGroupSize {
+			continue
+		}
+		index := placementGroupShardIndex(shard)
+		if picked == nil || index < pickedIndex {
+			picked = shard
+			pickedIndex = index
+		}
+	}
+	return picked
+}
+
+// nextPlacementGroupShardIndex returns the lowest index not used by any shard.
+func nextPlacementGroupShardIndex(shards []*hcloud.PlacementGroup) int {
+	used := make(map[int]bool, len(shards))
+	for _, shard := range shards {
+		used[placementGroupShardIndex(shard)] = true
+	}
+	index := 0
+	for used[index] {
+		index++
+	}
+	return index
+}
+
+func placementGroupShardIndex(shard *hcloud.PlacementGroup) int {
+	index, err := strconv.Atoi(shard.Labels[placementGroupShardLabel])
+	if err != nil {
+		return -1
+	}
+	return index
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/hetzner/hcloud-go/hcloud"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/hetzner/hcloud-go/hcloud/schema"
+)
+
+func testPlacementGroupShard(id int64, index, servers int) *hcloud.PlacementGroup {
+	shard := &hcloud.PlacementGroup{
+		ID:     id,
+		Labels: map[string]string{placementGroupShardLabel: strconv.Itoa(index)},
+	}
+	for i := 0; i < servers; i++ {
+		shard.Servers = append(shard.Servers, int64(i))
+	}
+	return shard
+}
+
+func TestPickPlacementGroupShard(t *testing.T) {
+	full := testPlacementGroupShard(1, 0, maxPlacementGroupSize)
+	almostFull := testPlacementGroupShard(2, 2, maxPlacementGroupSize-1)
+	empty := testPlacementGroupShard(3, 1, 0)
+
+	assert.Nil(t, pickPlacementGroupShard(nil, nil))
+	assert.Nil(t, pickPlacementGroupShard([]*hcloud.PlacementGroup{full}, nil))
+	assert.Equal(t, empty, pickPlacementGroupShard([]*hcloud.PlacementGroup{full, almostFull, empty}, nil))
+	assert.Equal(t, almostFull, pickPlacementGroupShard([]*hcloud.PlacementGroup{almostFull}, map[int64]int{3: 10}))
+	assert.Nil(t, pickPlacementGroupShard([]*hcloud.PlacementGroup{full, almostFull}, map[int64]int{2: 1}))
+}
+
+func TestNextPlacementGroupShardIndex(t *testing.T) {
+	assert.Equal(t, 0, nextPlacementGroupShardIndex(nil))
+	assert.Equal(t, 2, nextPlacementGroupShardIndex([]*hcloud.PlacementGroup{
+		testPlacementGroupShard(1, 0, 1),
+		testPlacementGroupShard(2, 1, 1),
+		testPlacementGroupShard(3, 3, 1),
+	}))
+	assert.Equal(t, 0, nextPlacementGroupShardIndex([]*hcloud.PlacementGroup{testPlacementGroupShard(1, 1, 1)}))
+}
+
+// fakePlacementGroupAPI serves the placement group endpoints of the hcloud API
+// from memory.
+type fakePlacementGroupAPI struct {
+	mutex  sync.Mutex
+	groups map[int64]*schema.PlacementGroup
+	nextID int64
+}
+
+func newFakePlacementGroupAPI(t *testing.T, groups ...schema.PlacementGroup) (*fakePlacementGroupAPI, *hetznerManager) {
+	api := &fakePlacementGroupAPI{groups: make(map[int64]*schema.PlacementGroup), nextID: 1}
+	for i := range groups {
+		api.groups[groups[i].ID] = &groups[i]
+		api.nextID = max(api.nextID, groups[i].ID+1)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(server.Close)
+	manager := &hetznerManager{
+		client:         hcloud.NewClient(hcloud.WithEndpoint(server.URL), hcloud.WithToken("token")),
+		apiCallContext: context.Background(),
+	}
+	return api, manager
+}
+
+func (a *fakePlacementGroupAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/placement_groups":
+		response := schema.PlacementGroupListResponse{PlacementGroups: []schema.PlacementGroup{}}
+		for _, id := range slices.Sorted(maps.Keys(a.groups)) {
+			if placementGroupMatches(a.groups[id], r.URL.Query().Get("label_selector")) {
+				response.PlacementGroups = append(response.PlacementGroups, *a.groups[id])
+			}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	case r.Method == http.MethodPost && r.URL.Path == "/placement_groups":
+		var request schema.PlacementGroupCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		group := &schema.PlacementGroup{ID: a.nextID, Name: request.Name, Type: request.Type, Servers: []int64{}}
+		if request.Labels != nil {
+			group.Labels = *request.Labels
+		}
+		a.groups[group.ID] = group
+		a.nextID++
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(schema.PlacementGroupCreateResponse{PlacementGroup: *group})
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/placement_groups/"):
+		id, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/placement_groups/"), 10, 64)
+		if err != nil || a.groups[id] == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"not_found","message":"placement group not found"}}`))
+			return
+		}
+		delete(a.groups, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// addServer adds a server to a placement group, like creating a server in it would.
+func (a *fakePlacementGroupAPI) addServer(id int64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	group := a.groups[id]
+	group.Servers = append(group.Servers, int64(len(group.Servers)+1))
+}
+
+func (a *fakePlacementGroupAPI) names() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	var names []string
+	for _, group := range a.groups {
+		names = append(names, group.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// placementGroupMatches supports the "key=value" and "key" terms of label selectors.
+func placementGroupMatches(group *schema.PlacementGroup, selector string) bool {
+	for _, term := range strings.Split(selector, ",") {
+		if term == "" {
+			continue
+		}
+		key, value, hasValue := strings.Cut(term, "=")
+		actual, found := group.Labels[key]
+		if !found || hasValue && actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func testPlacementGroupShardSchema(id int64, nodeGroup string, index, servers int) schema.PlacementGroup {
+	group := schema.PlacementGroup{
+		ID:   id,
+		Name: nodeGroup + "-" + strconv.Itoa(index),
+		Labels: map[string]string{
+			nodeGroupLabel:           nodeGroup,
+			placementGroupShardLabel: strconv.Itoa(index),
+		},
+		Type:    string(hcloud.PlacementGroupTypeSpread),
+		Servers: []int64{},
+	}
+	for i := 0; i < servers; i++ {
+		group.Servers = append(group.Servers, int64(i+1))
+	}
+	return group
+}
+
+func TestPlacementGroupShardsAcquire(t *testing.T) {
+	api, manager := newFakePlacementGroupAPI(t,
+		testPlacementGroupShardSchema(10, "other-pool", 0, 0))
+	shards := newPlacementGroupShards(manager, "pool1", "")
+	ctx := context.Background()
+
+	// The first shard is created when the node group has none.
+	first, err := shards.acquire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "pool1-0", first.Name)
+	assert.Equal(t, "0", first.Labels[placementGroupShardLabel])
+	assert.Equal(t, "pool1", first.Labels[nodeGroupLabel])
+	assert.Equal(t, []string{"other-pool-0", "pool1-0"}, api.names())
+
+	// The shard is reused while it has room, counting the servers being created.
+	for i := 1; i < maxPlacementGroupSize; i++ {
+		shard, err := shards.acquire(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, shard.ID)
+	}
+	assert.Equal(t, []string{"other-pool-0", "pool1-0"}, api.names())
+
+	// Another shard is created once the first one is full.
+	second, err := shards.acquire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "pool1-1", second.Name)
+	assert.Equal(t, []string{"other-pool-0", "pool1-0", "pool1-1"}, api.names())
+
+	// Releasing a failed creation makes room again.
+	shards.release(first)
+	shard, err := shards.acquire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, shard.ID)
+
+	// Created servers keep counting once their reservation is released.
+	for i := 0; i < maxPlacementGroupSize; i++ {
+		api.addServer(first.ID)
+		shards.release(first)
+	}
+	shard, err = shards.acquire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, shard.ID)
+}
+
+func TestPlacementGroupShardsAcquireConcurrently(t *testing.T) {
+	api, manager := newFakePlacementGroupAPI(t)
+	shards := newPlacementGroupShards(manager, "pool1", "prefix")
+
+	var wg sync.WaitGroup
+	ids := make([]int64, 2*maxPlacementGroupSize)
+	for i := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shard, err := shards.acquire(context.Background())
+			if assert.NoError(t, err) {
+				ids[i] = shard.ID
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Concurrent acquires fill two shards without creating more.
+	assert.Equal(t, []string{"prefix-0", "prefix-1"}, api.names())
+	counts := make(map[int64]int)
+	for _, id := range ids {
+		counts[id]++
+	}
+	assert.Len(t, counts, 2)
+	for _, count := range counts {
+		assert.Equal(t, maxPlacementGroupSize, count)
+	}
+}
+
+func TestPlacementGroupShardsCleanup(t *testing.T) {
+	api, manager := newFakePlacementGroupAPI(t,
+		testPlacementGroupShardSchema(1, "pool1", 0, maxPlacementGroupSize),
+		testPlacementGroupShardSchema(2, "pool1", 1, 0),
+		testPlacementGroupShardSchema(3, "pool1", 2, 0),
+		testPlacementGroupShardSchema(4, "other-pool", 0, 0))
+	shards := newPlacementGroupShards(manager, "pool1", "")
+	ctx := context.Background()
+
+	// Shard 1 has room and gets reserved.
+	reserved, err := shards.acquire(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), reserved.ID)
+
+	// Only empty and unreserved shards of the node group are deleted.
+	require.NoError(t, shards.cleanup(ctx))
+	assert.Equal(t, []string{"other-pool-0", "pool1-0", "pool1-1"}, api.names())
+
+	// Cleanups are skipped within the interval.
+	shards.release(reserved)
+	require.NoError(t, shards.cleanup(ctx))
+	assert.Equal(t, []string{"other-pool-0", "pool1-0", "pool1-1"}, api.names())
+
+	shards.lastCleanup = time.Now().Add(-placementGroupCleanupInterval)
+	require.NoError(t, shards.cleanup(ctx))
+	assert.Equal(t, []string{"other-pool-0", "pool1-0"}, api.names())
+
+	// The index of a deleted shard is used again.
+	shard, err := shards.acquire(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "pool1-1", shard.Name)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	redacted = "REDACTED"
+	// maxLoggedBodySize limits how much of an error response body is logged.
+	maxLoggedBodySize = 64 * 1024
+)
+
+// sensitiveBodyFields are JSON fields whose values are never written to the
+// request log.
+var sensitiveBodyFields = map[string]bool{
+	"user_data":     true,
+	"root_password": true,
+	"password":      true,
+	"token":         true,
+	"public_key":    true,
+	"private_key":   true,
+	"certificate":   true,
+}
+
+// requestLogEntry is a single line of the request log.
+type requestLogEntry struct {
+	Time               time.Time       `json:"time"`
+	Method             string          `json:"method"`
+	Path               string          `json:"path"`
+	Query              string          `json:"query,omitempty"`
+	Endpoint           string          `json:"endpoint"`
+	Status             int             `json:"status,omitempty"`
+	DurationSeconds    float64         `json:"durationSeconds"`
+	CorrelationID      string          `json:"correlationId,omitempty"`
+	RateLimitRemaining *int            `json:"rateLimitRemaining,omitempty"`
+	ErrorClass         string          `json:"errorClass,omitempty"`
+	Error              string          `json:"error,omitempty"`
+	ResponseBody       json.RawMessage `json:"responseBody,omitempty"`
+}
+
+// requestLogger writes one JSON line per hcloud API request. Request bodies and
+// headers are never logged; bodies of error responses are logged with
+// sensitive fields redacted.
+type requestLogger struct {
+	mutex sync.Mutex
+	out   io.Writer
+}
+
+// openRequestLog opens the request log at the given path for appending, "-"
+// logs to stderr.
+func openRequestLog(path string) (*requestLogger, error) {
+	if path == "-" {
+		return &requestLogger{out: os.Stderr}, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open request log %s: %v", path, err)
+	}
+	return &requestLogger{out: file}, nil
+}
+
+func (l *requestLogger) roundTripper(next http.RoundTripper) roundTripperFunc {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.RoundTrip(r)
+
+		entry := requestLogEntry{
+			Time:            start.UTC(),
+			Method:          r.Method,
+			Path:            r.URL.Path,
+			Query:           r.URL.RawQuery,
+			Endpoint:        preparePathForLabel(r.URL.Path),
+			DurationSeconds: time.Since(start).Seconds(),
+			ErrorClass:      classifyError(resp, err),
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.Status = resp.StatusCode
+			entry.CorrelationID = resp.Header.Get("X-Correlation-Id")
+			if remaining, err := strconv.Atoi(resp.Header.Get("RateLimit-Remaining")); err == nil {
+				entry.RateLimitRemaining = &remaining
+			}
+			if resp.StatusCode >= 400 && resp.Body != nil {
+				var body []byte
+				body, resp.Body = peekBody(resp.Body)
+				entry.ResponseBody = redactJSON(body)
+			}
+		}
+		l.write(entry)
+		return resp, err
+	})
+}
+
+func (l *requestLogger) write(entry requestLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	_, _ = l.out.Write(append(line, '\n'))
+}
+
+// peekBody reads up to maxLoggedBodySize bytes of body and returns them along
+// with a reader yielding the whole, unchanged body.
+func peekBody(body io.ReadCloser) ([]byte, io.ReadCloser) {
+	peeked, _ := io.ReadAll(io.LimitReader(body, maxLoggedBodySize))
+	return peeked, struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(peeked), body), body}
+}
+
+// redactJSON replaces the values of sensitive fields in a JSON document. It
+// returns nil if body isn't valid JSON, so unknown content is never logged.
+func redactJSON(body []byte) json.RawMessage {
+	var document any
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil
+	}
+	result, err := json.Marshal(redactValue(document))
+	if err != nil {
+		return nil
+	}
+	return result
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if sensitiveBodyFields[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
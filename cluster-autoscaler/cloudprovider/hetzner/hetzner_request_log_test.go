@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactJSON(t *testing.T) {
+	assert.Nil(t, redactJSON([]byte("not json")))
+	assert.JSONEq(t,
+		`{"server":{"name":"pool1-1","user_data":"REDACTED"},"root_password":"REDACTED","ssh_keys":[{"Public_Key":"REDACTED"}]}`,
+		string(redactJSON([]byte(`{"server":{"name":"pool1-1","user_data":"#cloud-config"},"root_password":"secret","ssh_keys":[{"Public_Key":"ssh-ed25519 AAAA"}]}`))))
+}
+
+func TestClassifyError(t *testing.T) {
+	assert.Equal(t, "", classifyError(&http.Response{StatusCode: http.StatusOK}, nil))
+	assert.Equal(t, errorClassRateLimited, classifyError(&http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+	assert.Equal(t, errorClassClient, classifyError(&http.Response{StatusCode: http.StatusUnprocessableEntity}, nil))
+	assert.Equal(t, errorClassServer, classifyError(&http.Response{StatusCode: http.StatusServiceUnavailable}, nil))
+	assert.Equal(t, errorClassTimeout, classifyError(nil, context.DeadlineExceeded))
+	assert.Equal(t, errorClassCanceled, classifyError(nil, context.Canceled))
+	assert.Equal(t, errorClassTransport, classifyError(nil, io.ErrUnexpectedEOF))
+}
+
+func TestRequestLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := &requestLogger{out: out}
+	responseBody := `{"error":{"code":"rate_limit_exceeded","message":"limit reached"},"token":"secret"}`
+	transport := logger.roundTripper(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("RateLimit-Remaining", "0")
+		header.Set("X-Correlation-Id", "abc")
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(responseBody)),
+			Request:    r,
+		}, nil
+	}))
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.hetzner.cloud/v1/servers?name=pool1", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	// The body is still readable by the hcloud client.
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, responseBody, string(body))
+
+	assert.NotContains(t, out.String(), "secret")
+	var entry requestLogEntry
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, "POST", entry.Method)
+	assert.Equal(t, "/v1/servers", entry.Path)
+	assert.Equal(t, "name=pool1", entry.Query)
+	assert.Equal(t, "/servers", entry.Endpoint)
+	assert.Equal(t, http.StatusTooManyRequests, entry.Status)
+	assert.Equal(t, "abc", entry.CorrelationID)
+	require.NotNil(t, entry.RateLimitRemaining)
+	assert.Equal(t, 0, *entry.RateLimitRemaining)
+	assert.Equal(t, errorClassRateLimited, entry.ErrorClass)
+	assert.JSONEq(t, `{"error":{"code":"rate_limit_exceeded","message":"limit reached"},"token":"REDACTED"}`, string(entry.ResponseBody))
+}
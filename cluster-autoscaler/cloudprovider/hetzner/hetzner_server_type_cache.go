@@ -34,6 +34,10 @@ const (
 	serverTypeCachedTTL   = time.Minute * 10
 	serverTypeCacheMinTTL = 5
 	serverTypeCacheMaxTTL = 60
+
+	// serverTypeUnavailableTTL is how long a server type is skipped in a location
+	// after server creation failed there because the type was unavailable.
+	serverTypeUnavailableTTL = time.Minute * 5
 )
 
 type serverTypeCache struct {
@@ -41,6 +45,14 @@ type serverTypeCache struct {
 	mngJitterClock      clock.Clock
 	hcloudClient        *hcloud.Client
 	hcloudClientContext context.Context
+
+	unavailableMutex sync.Mutex
+	unavailable      map[serverTypeLocation]time.Time
+}
+
+type serverTypeLocation struct {
+	serverType string
+	location   string
 }
 
 type serverTypeClock struct {
@@ -66,7 +78,7 @@ type serverTypeCachedObject struct {
 }
 
 func newServerTypeCache(ctx context.Context, hcloudClient *hcloud.Client) *serverTypeCache {
-	jc := &serverTypeClock{}
+	jc := &serverTypeClock{Clock: clock.RealClock{}}
 	return newServerTypeCacheWithClock(
 		ctx,
 		hcloudClient,
@@ -82,11 +94,40 @@ func newServerTypeCache(ctx context.Context, hcloudClient *hcloud.Client) *serve
 
 func newServerTypeCacheWithClock(ctx context.Context, hcloudClient *hcloud.Client, jc clock.Clock, store cache.Store) *serverTypeCache {
 	return &serverTypeCache{
-		store,
-		jc,
-		hcloudClient,
-		ctx,
+		Store:               store,
+		mngJitterClock:      jc,
+		hcloudClient:        hcloudClient,
+		hcloudClientContext: ctx,
+		unavailable:         make(map[serverTypeLocation]time.Time),
+	}
+}
+
+// markUnavailable records that servers of the given type can't currently be
+// created in the given location.
+func (m *serverTypeCache) markUnavailable(serverType, location string) {
+	m.unavailableMutex.Lock()
+	defer m.unavailableMutex.Unlock()
+
+	klog.Warningf("Server type %s is unavailable in location %s, skipping it for %s", serverType, location, serverTypeUnavailableTTL)
+	m.unavailable[serverTypeLocation{serverType, location}] = m.mngJitterClock.Now().Add(serverTypeUnavailableTTL)
+}
+
+// isUnavailable returns whether the given server type was recently found to be
+// unavailable in the given location.
+func (m *serverTypeCache) isUnavailable(serverType, location string) bool {
+	m.unavailableMutex.Lock()
+	defer m.unavailableMutex.Unlock()
+
+	key := serverTypeLocation{serverType, location}
+	expiry, found := m.unavailable[key]
+	if !found {
+		return false
+	}
+	if m.mngJitterClock.Now().After(expiry) {
+		delete(m.unavailable, key)
+		return false
 	}
+	return true
 }
 
 func (m *serverTypeCache) serverTypes() ([]*hcloud.ServerType, error) {
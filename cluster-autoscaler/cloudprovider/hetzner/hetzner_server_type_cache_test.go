@@ -19,10 +19,13 @@ package hetzner
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/hetzner/hcloud-go/hcloud"
+	"k8s.io/client-go/tools/cache"
+	clocktesting "k8s.io/utils/clock/testing"
 )
 
 func TestServerTypeCache(t *testing.T) {
@@ -60,3 +63,22 @@ func TestServerTypeCache(t *testing.T) {
 	_, err = c.getServerType("test3")
 	require.Error(t, err)
 }
+
+func TestServerTypeCacheUnavailable(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	c := newServerTypeCacheWithClock(context.Background(), nil, fakeClock, cache.NewStore(cache.MetaNamespaceKeyFunc))
+
+	assert.False(t, c.isUnavailable("cx22", "fsn1"))
+
+	c.markUnavailable("cx22", "fsn1")
+	assert.True(t, c.isUnavailable("cx22", "fsn1"))
+	assert.False(t, c.isUnavailable("cx22", "nbg1"))
+	assert.False(t, c.isUnavailable("cx32", "fsn1"))
+
+	fakeClock.Step(serverTypeUnavailableTTL - time.Second)
+	assert.True(t, c.isUnavailable("cx22", "fsn1"))
+
+	fakeClock.Step(2 * time.Second)
+	assert.False(t, c.isUnavailable("cx22", "fsn1"))
+	assert.Empty(t, c.unavailable)
+}
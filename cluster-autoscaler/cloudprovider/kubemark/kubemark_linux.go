@@ -23,14 +23,20 @@ limitations under the License.
 package kubemark
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
 	kubeclient "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/kubernetes/pkg/kubemark"
@@ -59,6 +65,9 @@ const (
 
 	// GPULabel is the label added to nodes with GPU resource.
 	GPULabel = "cloud.google.com/gke-accelerator"
+
+	// injectedFailureErrorCode is the error code of nodes failed by failure injection.
+	injectedFailureErrorCode = "KUBEMARK_INJECTED_FAILURE"
 )
 
 var (
@@ -74,6 +83,11 @@ type KubemarkCloudProvider struct {
 	kubemarkController *kubemark.KubemarkController
 	nodeGroups         []*NodeGroup
 	resourceLimiter    *cloudprovider.ResourceLimiter
+
+	// kubemarkClient and nodeLister are used to apply the labels and taints
+	// of node group templates to hollow nodes. Both are optional.
+	kubemarkClient kubeclient.Interface
+	nodeLister     corelisters.NodeLister
 }
 
 // BuildKubemarkCloudProvider builds a CloudProvider for kubemark. Builds
@@ -181,20 +195,83 @@ func (kubemark *KubemarkCloudProvider) GetResourceLimiter() (*cloudprovider.Reso
 // Refresh is called before every main loop and can be used to dynamically update cloud provider state.
 // In particular the list of node groups returned by NodeGroups can change as a result of CloudProvider.Refresh().
 func (kubemark *KubemarkCloudProvider) Refresh() error {
+	if kubemark.kubemarkClient == nil || kubemark.nodeLister == nil {
+		return nil
+	}
+	for _, nodeGroup := range kubemark.nodeGroups {
+		kubemark.applyTemplate(nodeGroup)
+	}
 	return nil
 }
 
+// applyTemplate adds the labels and taints of the node group template to the
+// hollow nodes of the node group that don't have them yet. Nodes that fail to
+// be updated are retried on the next refresh.
+func (kubemark *KubemarkCloudProvider) applyTemplate(nodeGroup *NodeGroup) {
+	if len(nodeGroup.template.labels) == 0 && len(nodeGroup.template.taints) == 0 {
+		return
+	}
+	names, err := nodeGroup.kubemarkController.GetNodeNamesForNodeGroup(nodeGroup.Name)
+	if err != nil {
+		klog.Errorf("failed to get nodes of node group %s to apply its template: %v", nodeGroup.Name, err)
+		return
+	}
+	for _, name := range names {
+		node, err := kubemark.nodeLister.Get(name)
+		if err != nil {
+			// The hollow node hasn't registered yet.
+			continue
+		}
+		updated := nodeGroup.template.missingLabelsAndTaints(node)
+		if updated == nil {
+			continue
+		}
+		if _, err := kubemark.kubemarkClient.CoreV1().Nodes().Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+			klog.Errorf("failed to apply template of node group %s to node %s: %v", nodeGroup.Name, name, err)
+			continue
+		}
+		klog.V(4).Infof("applied template of node group %s to node %s", nodeGroup.Name, name)
+	}
+}
+
 // Cleanup cleans up all resources before the cloud provider is removed
 func (kubemark *KubemarkCloudProvider) Cleanup() error {
 	return nil
 }
 
+// nodeGroupController is the part of the kubemark controller used by node groups.
+type nodeGroupController interface {
+	GetNodeNamesForNodeGroup(nodeGroup string) ([]string, error)
+	GetNodeGroupTargetSize(nodeGroup string) (int, error)
+	SetNodeGroupSize(nodeGroup string, size int) error
+	RemoveNodeFromNodeGroup(nodeGroup string, node string) error
+}
+
 // NodeGroup implements NodeGroup interface.
 type NodeGroup struct {
 	Name               string
-	kubemarkController *kubemark.KubemarkController
+	kubemarkController nodeGroupController
 	minSize            int
 	maxSize            int
+
+	template          hollowNodeTemplate
+	provisioningDelay time.Duration
+	failureRate       float64
+
+	// pendingLock guards pending and pendingSeq.
+	pendingLock sync.Mutex
+	// pending holds nodes requested by IncreaseSize that haven't been handed
+	// to the kubemark controller yet, either because the provisioning delay
+	// hasn't passed or because they failed.
+	pending    []*pendingNode
+	pendingSeq int
+}
+
+// pendingNode is a node that is being provisioned.
+type pendingNode struct {
+	id      string
+	readyAt time.Time
+	failed  bool
 }
 
 // Id returns nodegroup name.
@@ -227,12 +304,27 @@ func (nodeGroup *NodeGroup) Nodes() ([]cloudprovider.Instance, error) {
 	for _, node := range nodes {
 		instances = append(instances, cloudprovider.Instance{Id: "kubemark://" + node})
 	}
+
+	nodeGroup.pendingLock.Lock()
+	defer nodeGroup.pendingLock.Unlock()
+	now := time.Now()
+	for _, node := range nodeGroup.pending {
+		status := &cloudprovider.InstanceStatus{State: cloudprovider.InstanceCreating}
+		if node.failed && !now.Before(node.readyAt) {
+			status.ErrorInfo = &cloudprovider.InstanceErrorInfo{
+				ErrorClass:   cloudprovider.OutOfResourcesErrorClass,
+				ErrorCode:    injectedFailureErrorCode,
+				ErrorMessage: "kubemark failed to provision the node on purpose",
+			}
+		}
+		instances = append(instances, cloudprovider.Instance{Id: node.id, Status: status})
+	}
 	return instances, nil
 }
 
 // DeleteNodes deletes the specified nodes from the node group.
 func (nodeGroup *NodeGroup) DeleteNodes(nodes []*apiv1.Node) error {
-	size, err := nodeGroup.kubemarkController.GetNodeGroupTargetSize(nodeGroup.Name)
+	size, err := nodeGroup.TargetSize()
 	if err != nil {
 		return err
 	}
@@ -240,6 +332,9 @@ func (nodeGroup *NodeGroup) DeleteNodes(nodes []*apiv1.Node) error {
 		return fmt.Errorf("min size reached, nodes will not be deleted")
 	}
 	for _, node := range nodes {
+		if nodeGroup.removePending(node.Spec.ProviderID) {
+			continue
+		}
 		if err := nodeGroup.kubemarkController.RemoveNodeFromNodeGroup(nodeGroup.Name, node.ObjectMeta.Name); err != nil {
 			return err
 		}
@@ -257,15 +352,84 @@ func (nodeGroup *NodeGroup) IncreaseSize(delta int) error {
 	if delta <= 0 {
 		return fmt.Errorf("size increase must be positive")
 	}
-	size, err := nodeGroup.kubemarkController.GetNodeGroupTargetSize(nodeGroup.Name)
+	size, err := nodeGroup.TargetSize()
 	if err != nil {
 		return err
 	}
-	newSize := int(size) + delta
+	newSize := size + delta
 	if newSize > nodeGroup.MaxSize() {
 		return fmt.Errorf("size increase too large, desired: %d max: %d", newSize, nodeGroup.MaxSize())
 	}
-	return nodeGroup.kubemarkController.SetNodeGroupSize(nodeGroup.Name, newSize)
+	if nodeGroup.provisioningDelay == 0 && nodeGroup.failureRate == 0 {
+		return nodeGroup.kubemarkController.SetNodeGroupSize(nodeGroup.Name, newSize)
+	}
+
+	nodeGroup.pendingLock.Lock()
+	readyAt := time.Now().Add(nodeGroup.provisioningDelay)
+	for i := 0; i < delta; i++ {
+		nodeGroup.pendingSeq++
+		nodeGroup.pending = append(nodeGroup.pending, &pendingNode{
+			id:      fmt.Sprintf("%s://%s-pending-%d", ProviderName, nodeGroup.Name, nodeGroup.pendingSeq),
+			readyAt: readyAt,
+			failed:  rand.Float64() < nodeGroup.failureRate,
+		})
+	}
+	nodeGroup.pendingLock.Unlock()
+	time.AfterFunc(nodeGroup.provisioningDelay, nodeGroup.provisionPending)
+	return nil
+}
+
+// provisionPending hands the pending nodes whose provisioning delay has passed
+// to the kubemark controller. Failed nodes stay pending until deleted.
+func (nodeGroup *NodeGroup) provisionPending() {
+	nodeGroup.pendingLock.Lock()
+	defer nodeGroup.pendingLock.Unlock()
+
+	now := time.Now()
+	ready := 0
+	remaining := make([]*pendingNode, 0, len(nodeGroup.pending))
+	for _, node := range nodeGroup.pending {
+		if node.failed || now.Before(node.readyAt) {
+			remaining = append(remaining, node)
+			continue
+		}
+		ready++
+	}
+	if ready == 0 {
+		return
+	}
+	size, err := nodeGroup.kubemarkController.GetNodeGroupTargetSize(nodeGroup.Name)
+	if err == nil {
+		err = nodeGroup.kubemarkController.SetNodeGroupSize(nodeGroup.Name, size+ready)
+	}
+	if err != nil {
+		klog.Errorf("failed to provision %d nodes of node group %s, will retry: %v", ready, nodeGroup.Name, err)
+		time.AfterFunc(time.Second, nodeGroup.provisionPending)
+		return
+	}
+	nodeGroup.pending = remaining
+}
+
+// removePending removes the pending node with the given id, returning whether
+// it was found.
+func (nodeGroup *NodeGroup) removePending(id string) bool {
+	nodeGroup.pendingLock.Lock()
+	defer nodeGroup.pendingLock.Unlock()
+
+	for i, node := range nodeGroup.pending {
+		if node.id == id {
+			nodeGroup.pending = append(nodeGroup.pending[:i], nodeGroup.pending[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// pendingCount returns the number of pending nodes.
+func (nodeGroup *NodeGroup) pendingCount() int {
+	nodeGroup.pendingLock.Lock()
+	defer nodeGroup.pendingLock.Unlock()
+	return len(nodeGroup.pending)
 }
 
 // AtomicIncreaseSize is not implemented.
@@ -277,7 +441,7 @@ func (nodeGroup *NodeGroup) AtomicIncreaseSize(delta int) error {
 // number is different from the number of nodes registered in Kubernetes.
 func (nodeGroup *NodeGroup) TargetSize() (int, error) {
 	size, err := nodeGroup.kubemarkController.GetNodeGroupTargetSize(nodeGroup.Name)
-	return int(size), err
+	return int(size) + nodeGroup.pendingCount(), err
 }
 
 // DecreaseTargetSize decreases the target size of the node group. This function
@@ -287,6 +451,10 @@ func (nodeGroup *NodeGroup) DecreaseTargetSize(delta int) error {
 	if delta >= 0 {
 		return fmt.Errorf("size decrease must be negative")
 	}
+	delta += nodeGroup.cancelPending(-delta)
+	if delta == 0 {
+		return nil
+	}
 	size, err := nodeGroup.kubemarkController.GetNodeGroupTargetSize(nodeGroup.Name)
 	if err != nil {
 		return err
@@ -303,9 +471,27 @@ func (nodeGroup *NodeGroup) DecreaseTargetSize(delta int) error {
 	return nodeGroup.kubemarkController.SetNodeGroupSize(nodeGroup.Name, newSize)
 }
 
+// cancelPending removes up to count pending nodes that haven't failed,
+// starting with the most recent ones, and returns how many were removed.
+func (nodeGroup *NodeGroup) cancelPending(count int) int {
+	nodeGroup.pendingLock.Lock()
+	defer nodeGroup.pendingLock.Unlock()
+
+	cancelled := 0
+	for i := len(nodeGroup.pending) - 1; i >= 0 && cancelled < count; i-- {
+		if nodeGroup.pending[i].failed {
+			continue
+		}
+		nodeGroup.pending = append(nodeGroup.pending[:i], nodeGroup.pending[i+1:]...)
+		cancelled++
+	}
+	return cancelled
+}
+
 // TemplateNodeInfo returns a node template for this node group.
 func (nodeGroup *NodeGroup) TemplateNodeInfo() (*framework.NodeInfo, error) {
-	return nil, cloudprovider.ErrNotImplemented
+	node := nodeGroup.template.buildNode(fmt.Sprintf("%s-template-%d", nodeGroup.Name, rand.Int63()))
+	return framework.NewNodeInfo(node, nil, framework.NewPodInfo(cloudprovider.BuildKubeProxy(nodeGroup.Name), nil)), nil
 }
 
 // Exist checks if the node group really exists on the cloud provider side.
@@ -335,16 +521,24 @@ func (nodeGroup *NodeGroup) GetOptions(defaults config.NodeGroupAutoscalingOptio
 }
 
 func buildNodeGroup(value string, kubemarkController *kubemark.KubemarkController) (*NodeGroup, error) {
+	value, optionsValue := splitNodeGroupSpec(value)
 	spec, err := dynamic.SpecFromString(value, true)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse node group spec: %v", err)
 	}
+	options, err := parseNodeGroupOptions(optionsValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse options of node group %s: %v", spec.Name, err)
+	}
 
 	nodeGroup := &NodeGroup{
 		Name:               spec.Name,
 		kubemarkController: kubemarkController,
 		minSize:            spec.MinSize,
 		maxSize:            spec.MaxSize,
+		template:           options.template,
+		provisioningDelay:  options.provisioningDelay,
+		failureRate:        options.failureRate,
 	}
 
 	return nodeGroup, nil
@@ -392,5 +586,7 @@ func BuildKubemark(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGro
 	if err != nil {
 		klog.Fatalf("Failed to create Kubemark cloud provider: %v", err)
 	}
+	provider.kubemarkClient = kubemarkClient
+	provider.nodeLister = kubemarkNodeInformer.Lister()
 	return provider
 }
@@ -0,0 +1,276 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubemark
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+)
+
+// fakeNodeGroupController keeps the node groups of the kubemark controller in
+// memory. Nodes are created as soon as the target size is increased.
+type fakeNodeGroupController struct {
+	mutex      sync.Mutex
+	nodes      map[string][]string
+	setSizeErr error
+}
+
+func newFakeNodeGroupController() *fakeNodeGroupController {
+	return &fakeNodeGroupController{nodes: make(map[string][]string)}
+}
+
+func (c *fakeNodeGroupController) GetNodeNamesForNodeGroup(nodeGroup string) ([]string, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]string(nil), c.nodes[nodeGroup]...), nil
+}
+
+func (c *fakeNodeGroupController) GetNodeGroupTargetSize(nodeGroup string) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.nodes[nodeGroup]), nil
+}
+
+func (c *fakeNodeGroupController) SetNodeGroupSize(nodeGroup string, size int) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.setSizeErr != nil {
+		return c.setSizeErr
+	}
+	for len(c.nodes[nodeGroup]) < size {
+		c.nodes[nodeGroup] = append(c.nodes[nodeGroup], fmt.Sprintf("%s-%d", nodeGroup, len(c.nodes[nodeGroup])))
+	}
+	c.nodes[nodeGroup] = c.nodes[nodeGroup][:size]
+	return nil
+}
+
+func (c *fakeNodeGroupController) RemoveNodeFromNodeGroup(nodeGroup string, node string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for i, name := range c.nodes[nodeGroup] {
+		if name == node {
+			c.nodes[nodeGroup] = append(c.nodes[nodeGroup][:i], c.nodes[nodeGroup][i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("node %s not found in node group %s", node, nodeGroup)
+}
+
+func (c *fakeNodeGroupController) setError(err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.setSizeErr = err
+}
+
+func buildTestNodeGroup(t *testing.T, spec string, controller nodeGroupController) *NodeGroup {
+	nodeGroup, err := buildNodeGroup(spec, nil)
+	assert.NoError(t, err)
+	nodeGroup.kubemarkController = controller
+	return nodeGroup
+}
+
+// makePendingReady makes the provisioning delay of all pending nodes pass.
+func makePendingReady(nodeGroup *NodeGroup) {
+	nodeGroup.pendingLock.Lock()
+	defer nodeGroup.pendingLock.Unlock()
+	for _, node := range nodeGroup.pending {
+		node.readyAt = time.Now().Add(-time.Second)
+	}
+}
+
+func instanceStates(t *testing.T, nodeGroup *NodeGroup) (running, creating, failed int) {
+	instances, err := nodeGroup.Nodes()
+	assert.NoError(t, err)
+	for _, instance := range instances {
+		switch {
+		case instance.Status == nil:
+			running++
+		case instance.Status.ErrorInfo != nil:
+			assert.Equal(t, cloudprovider.OutOfResourcesErrorClass, instance.Status.ErrorInfo.ErrorClass)
+			assert.Equal(t, injectedFailureErrorCode, instance.Status.ErrorInfo.ErrorCode)
+			failed++
+		default:
+			assert.Equal(t, cloudprovider.InstanceCreating, instance.Status.State)
+			creating++
+		}
+	}
+	return running, creating, failed
+}
+
+func TestIncreaseSize(t *testing.T) {
+	controller := newFakeNodeGroupController()
+	nodeGroup := buildTestNodeGroup(t, "0:5:group", controller)
+
+	assert.Error(t, nodeGroup.IncreaseSize(0))
+	assert.Error(t, nodeGroup.IncreaseSize(6))
+
+	// Without delay nor failures the nodes are created right away.
+	assert.NoError(t, nodeGroup.IncreaseSize(2))
+	size, err := nodeGroup.TargetSize()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, size)
+	assert.Equal(t, 0, nodeGroup.pendingCount())
+
+	// With a provisioning delay the nodes are created once it has passed.
+	nodeGroup = buildTestNodeGroup(t, "0:5:delayed:provisioning-delay=1h", controller)
+	assert.NoError(t, nodeGroup.IncreaseSize(3))
+	size, err = nodeGroup.TargetSize()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, size)
+	running, creating, failed := instanceStates(t, nodeGroup)
+	assert.Equal(t, []int{0, 3, 0}, []int{running, creating, failed})
+	assert.Error(t, nodeGroup.IncreaseSize(3))
+
+	nodeGroup.provisionPending()
+	assert.Equal(t, 3, nodeGroup.pendingCount())
+
+	makePendingReady(nodeGroup)
+	nodeGroup.provisionPending()
+	assert.Equal(t, 0, nodeGroup.pendingCount())
+	running, creating, failed = instanceStates(t, nodeGroup)
+	assert.Equal(t, []int{3, 0, 0}, []int{running, creating, failed})
+}
+
+func TestProvisionPendingRetriesOnError(t *testing.T) {
+	controller := newFakeNodeGroupController()
+	nodeGroup := buildTestNodeGroup(t, "0:5:group:provisioning-delay=1h", controller)
+	assert.NoError(t, nodeGroup.IncreaseSize(2))
+	makePendingReady(nodeGroup)
+
+	// Pending nodes are kept when the controller fails and retried later.
+	controller.setError(fmt.Errorf("rc creation failed"))
+	nodeGroup.provisionPending()
+	assert.Equal(t, 2, nodeGroup.pendingCount())
+
+	controller.setError(nil)
+	assert.Eventually(t, func() bool {
+		return nodeGroup.pendingCount() == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	size, err := nodeGroup.TargetSize()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, size)
+}
+
+func TestFailureInjection(t *testing.T) {
+	controller := newFakeNodeGroupController()
+	nodeGroup := buildTestNodeGroup(t, "0:5:group:provisioning-delay=1h,failure-rate=1", controller)
+	assert.NoError(t, nodeGroup.IncreaseSize(2))
+
+	// Failed nodes are reported as being created until the delay has passed.
+	running, creating, failed := instanceStates(t, nodeGroup)
+	assert.Equal(t, []int{0, 2, 0}, []int{running, creating, failed})
+
+	makePendingReady(nodeGroup)
+	nodeGroup.provisionPending()
+	running, creating, failed = instanceStates(t, nodeGroup)
+	assert.Equal(t, []int{0, 0, 2}, []int{running, creating, failed})
+
+	// Failed nodes are not cancelled, only deleted.
+	assert.Error(t, nodeGroup.DecreaseTargetSize(-1))
+	assert.Equal(t, 2, nodeGroup.pendingCount())
+
+	instances, err := nodeGroup.Nodes()
+	assert.NoError(t, err)
+	failedNode := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "failed"},
+		Spec:       apiv1.NodeSpec{ProviderID: instances[0].Id},
+	}
+	assert.NoError(t, nodeGroup.DeleteNodes([]*apiv1.Node{failedNode}))
+	assert.Equal(t, 1, nodeGroup.pendingCount())
+	size, err := controller.GetNodeGroupTargetSize("group")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, size)
+}
+
+func TestCancelPending(t *testing.T) {
+	controller := newFakeNodeGroupController()
+	nodeGroup := buildTestNodeGroup(t, "0:10:group:provisioning-delay=1h", controller)
+	assert.NoError(t, controller.SetNodeGroupSize("group", 2))
+	assert.NoError(t, nodeGroup.IncreaseSize(3))
+	nodeGroup.pending[1].failed = true
+
+	// The most recent pending nodes that haven't failed are cancelled first.
+	assert.Equal(t, 1, nodeGroup.cancelPending(1))
+	assert.Equal(t, []string{"kubemark://group-pending-1", "kubemark://group-pending-2"}, pendingIDs(nodeGroup))
+	assert.Equal(t, 1, nodeGroup.cancelPending(5))
+	assert.Equal(t, []string{"kubemark://group-pending-2"}, pendingIDs(nodeGroup))
+
+	// Decreasing the target size further never deletes registered nodes.
+	assert.Error(t, nodeGroup.DecreaseTargetSize(-1))
+	size, err := nodeGroup.TargetSize()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, size)
+}
+
+func pendingIDs(nodeGroup *NodeGroup) []string {
+	nodeGroup.pendingLock.Lock()
+	defer nodeGroup.pendingLock.Unlock()
+	var ids []string
+	for _, node := range nodeGroup.pending {
+		ids = append(ids, node.id)
+	}
+	return ids
+}
+
+func TestRefreshContinuesOnUpdateErrors(t *testing.T) {
+	controller := newFakeNodeGroupController()
+	assert.NoError(t, controller.SetNodeGroupSize("group", 3))
+	nodeGroup := buildTestNodeGroup(t, "0:5:group:label=tier=batch", controller)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	var objects []runtime.Object
+	for _, name := range []string{"group-0", "group-1", "group-2"} {
+		node := &apiv1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		assert.NoError(t, indexer.Add(node))
+		objects = append(objects, node)
+	}
+	client := fake.NewSimpleClientset(objects...)
+	client.PrependReactor("update", "nodes", func(action core.Action) (bool, runtime.Object, error) {
+		if action.(core.UpdateAction).GetObject().(*apiv1.Node).Name == "group-0" {
+			return true, nil, fmt.Errorf("conflict")
+		}
+		return false, nil, nil
+	})
+	provider := &KubemarkCloudProvider{
+		nodeGroups:     []*NodeGroup{nodeGroup},
+		kubemarkClient: client,
+		nodeLister:     corelisters.NewNodeLister(indexer),
+	}
+
+	assert.NoError(t, provider.Refresh())
+	for name, labeled := range map[string]bool{"group-0": false, "group-1": true, "group-2": true} {
+		node, err := client.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, labeled, node.Labels["tier"] == "batch", name)
+	}
+}
@@ -0,0 +1,221 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubemark
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// hollowNodeCPU and hollowNodeMemory are the capacity reported by the fake
+	// cadvisor of every hollow kubelet.
+	hollowNodeCPU    = "1"
+	hollowNodeMemory = "3840Mi"
+	// defaultHollowNodePods matches the default --max-pods of hollow kubelets.
+	defaultHollowNodePods = "110"
+)
+
+// nodeGroupOptions holds the options given after the name of a node group
+// spec, e.g. 1:10:group:pods=50,label=tier=batch,taint=dedicated=batch:NoSchedule.
+type nodeGroupOptions struct {
+	template          hollowNodeTemplate
+	provisioningDelay time.Duration
+	failureRate       float64
+}
+
+// hollowNodeTemplate describes the nodes of a node group.
+type hollowNodeTemplate struct {
+	cpu    resource.Quantity
+	memory resource.Quantity
+	pods   resource.Quantity
+	labels map[string]string
+	taints []apiv1.Taint
+}
+
+// splitNodeGroupSpec splits the options off a node group spec, returning the
+// min:max:name part and the options.
+func splitNodeGroupSpec(value string) (string, string) {
+	tokens := strings.SplitN(value, ":", 4)
+	if len(tokens) < 4 {
+		return value, ""
+	}
+	return strings.Join(tokens[:3], ":"), tokens[3]
+}
+
+// parseNodeGroupOptions parses comma separated key=value options. The label
+// and taint options may be repeated. All hollow nodes are created from the same
+// hollow node template, so cpu and memory can't differ from what hollow
+// kubelets report and GPUs are not supported.
+func parseNodeGroupOptions(value string) (*nodeGroupOptions, error) {
+	options := &nodeGroupOptions{
+		template: hollowNodeTemplate{
+			cpu:    resource.MustParse(hollowNodeCPU),
+			memory: resource.MustParse(hollowNodeMemory),
+			pods:   resource.MustParse(defaultHollowNodePods),
+			labels: map[string]string{},
+		},
+	}
+	if value == "" {
+		return options, nil
+	}
+	for _, option := range strings.Split(value, ",") {
+		key, val, found := strings.Cut(option, "=")
+		if !found {
+			return nil, fmt.Errorf("option %q is not of the form key=value", option)
+		}
+		var err error
+		switch key {
+		case "cpu":
+			err = checkHollowNodeCapacity(val, options.template.cpu)
+		case "memory":
+			err = checkHollowNodeCapacity(val, options.template.memory)
+		case "pods":
+			options.template.pods, err = resource.ParseQuantity(val)
+		case "gpu", "gpu-type":
+			err = fmt.Errorf("hollow nodes don't report GPUs")
+		case "label":
+			labelKey, labelValue, _ := strings.Cut(val, "=")
+			options.template.labels[labelKey] = labelValue
+		case "taint":
+			var taint apiv1.Taint
+			taint, err = parseTaint(val)
+			options.template.taints = append(options.template.taints, taint)
+		case "provisioning-delay":
+			options.provisioningDelay, err = time.ParseDuration(val)
+		case "failure-rate":
+			options.failureRate, err = strconv.ParseFloat(val, 64)
+			if err == nil && (options.failureRate < 0 || options.failureRate > 1) {
+				err = fmt.Errorf("must be between 0 and 1")
+			}
+		default:
+			err = fmt.Errorf("unknown option")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid option %q: %v", option, err)
+		}
+	}
+	return options, nil
+}
+
+// checkHollowNodeCapacity returns an error if value is not the quantity
+// reported by hollow kubelets.
+func checkHollowNodeCapacity(value string, reported resource.Quantity) error {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return err
+	}
+	if quantity.Cmp(reported) != 0 {
+		return fmt.Errorf("hollow nodes always report %s", reported.String())
+	}
+	return nil
+}
+
+// parseTaint parses a taint given as key[=value]:effect.
+func parseTaint(value string) (apiv1.Taint, error) {
+	keyValue, effect, found := strings.Cut(value, ":")
+	if !found {
+		return apiv1.Taint{}, fmt.Errorf("taint is not of the form key[=value]:effect")
+	}
+	taint := apiv1.Taint{Effect: apiv1.TaintEffect(effect)}
+	taint.Key, taint.Value, _ = strings.Cut(keyValue, "=")
+	switch taint.Effect {
+	case apiv1.TaintEffectNoSchedule, apiv1.TaintEffectPreferNoSchedule, apiv1.TaintEffectNoExecute:
+	default:
+		return apiv1.Taint{}, fmt.Errorf("unknown taint effect %s", effect)
+	}
+	return taint, nil
+}
+
+// buildNode returns a node with the given name built from the template.
+func (t *hollowNodeTemplate) buildNode(name string) *apiv1.Node {
+	node := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				apiv1.LabelHostname:   name,
+				apiv1.LabelOSStable:   "linux",
+				apiv1.LabelArchStable: "amd64",
+			},
+		},
+		Spec: apiv1.NodeSpec{
+			ProviderID: ProviderName + "://" + name,
+			Taints:     append([]apiv1.Taint(nil), t.taints...),
+		},
+		Status: apiv1.NodeStatus{
+			Capacity: apiv1.ResourceList{
+				apiv1.ResourceCPU:    t.cpu,
+				apiv1.ResourceMemory: t.memory,
+				apiv1.ResourcePods:   t.pods,
+			},
+			Conditions: []apiv1.NodeCondition{
+				{Type: apiv1.NodeReady, Status: apiv1.ConditionTrue},
+			},
+		},
+	}
+	for key, value := range t.labels {
+		node.Labels[key] = value
+	}
+	node.Status.Allocatable = node.Status.Capacity
+	return node
+}
+
+// missingLabelsAndTaints returns a copy of node with the labels and taints of
+// the template added, or nil if the node has all of them already.
+func (t *hollowNodeTemplate) missingLabelsAndTaints(node *apiv1.Node) *apiv1.Node {
+	var updated *apiv1.Node
+	for key, value := range t.labels {
+		if current, found := node.Labels[key]; found && current == value {
+			continue
+		}
+		if updated == nil {
+			updated = node.DeepCopy()
+		}
+		if updated.Labels == nil {
+			updated.Labels = map[string]string{}
+		}
+		updated.Labels[key] = value
+	}
+	for _, taint := range t.taints {
+		if hasTaint(node.Spec.Taints, taint) {
+			continue
+		}
+		if updated == nil {
+			updated = node.DeepCopy()
+		}
+		updated.Spec.Taints = append(updated.Spec.Taints, taint)
+	}
+	return updated
+}
+
+func hasTaint(taints []apiv1.Taint, taint apiv1.Taint) bool {
+	for _, t := range taints {
+		if t.MatchTaint(&taint) {
+			return true
+		}
+	}
+	return false
+}
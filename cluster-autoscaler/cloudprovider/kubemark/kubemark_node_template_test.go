@@ -0,0 +1,111 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubemark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-autoscaler/pkg/utils/gpu"
+)
+
+func TestSplitNodeGroupSpec(t *testing.T) {
+	spec, options := splitNodeGroupSpec("1:10:group")
+	assert.Equal(t, "1:10:group", spec)
+	assert.Equal(t, "", options)
+
+	spec, options = splitNodeGroupSpec("1:10:group:cpu=4,taint=a=b:NoSchedule")
+	assert.Equal(t, "1:10:group", spec)
+	assert.Equal(t, "cpu=4,taint=a=b:NoSchedule", options)
+}
+
+func TestParseNodeGroupOptions(t *testing.T) {
+	options, err := parseNodeGroupOptions("")
+	assert.NoError(t, err)
+	assert.Equal(t, resource.MustParse("1"), options.template.cpu)
+	assert.Equal(t, resource.MustParse("3840Mi"), options.template.memory)
+	assert.Equal(t, time.Duration(0), options.provisioningDelay)
+
+	options, err = parseNodeGroupOptions("cpu=1000m,memory=3840Mi,pods=50," +
+		"label=tier=batch,label=spot,taint=dedicated=batch:NoSchedule,taint=gpu:NoExecute,provisioning-delay=90s,failure-rate=0.25")
+	assert.NoError(t, err)
+	assert.Equal(t, resource.MustParse("1"), options.template.cpu)
+	assert.Equal(t, resource.MustParse("3840Mi"), options.template.memory)
+	assert.Equal(t, resource.MustParse("50"), options.template.pods)
+	assert.Equal(t, map[string]string{"tier": "batch", "spot": ""}, options.template.labels)
+	assert.Equal(t, []apiv1.Taint{
+		{Key: "dedicated", Value: "batch", Effect: apiv1.TaintEffectNoSchedule},
+		{Key: "gpu", Effect: apiv1.TaintEffectNoExecute},
+	}, options.template.taints)
+	assert.Equal(t, 90*time.Second, options.provisioningDelay)
+	assert.Equal(t, 0.25, options.failureRate)
+
+	for _, invalid := range []string{
+		"cpu",
+		"cpu=lots",
+		"cpu=4",
+		"memory=16Gi",
+		"unknown=1",
+		"gpu=1",
+		"gpu-type=nvidia-tesla-k80",
+		"gpu=1,gpu-type=nvidia-tesla-k80",
+		"taint=a=b",
+		"taint=a=b:Sometimes",
+		"provisioning-delay=soon",
+		"failure-rate=1.5",
+	} {
+		_, err := parseNodeGroupOptions(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestBuildNode(t *testing.T) {
+	options, err := parseNodeGroupOptions("pods=50,label=tier=batch,taint=dedicated=batch:NoSchedule")
+	assert.NoError(t, err)
+
+	node := options.template.buildNode("group-template")
+	assert.Equal(t, "kubemark://group-template", node.Spec.ProviderID)
+	assert.Equal(t, "batch", node.Labels["tier"])
+	assert.Equal(t, "group-template", node.Labels[apiv1.LabelHostname])
+	assert.Equal(t, options.template.taints, node.Spec.Taints)
+	assert.Equal(t, int64(1), node.Status.Allocatable.Cpu().Value())
+	assert.Equal(t, resource.MustParse("3840Mi"), *node.Status.Allocatable.Memory())
+	assert.Equal(t, int64(50), node.Status.Allocatable.Pods().Value())
+	assert.NotContains(t, node.Status.Allocatable, gpu.ResourceNvidiaGPU)
+}
+
+func TestMissingLabelsAndTaints(t *testing.T) {
+	options, err := parseNodeGroupOptions("label=tier=batch,taint=dedicated=batch:NoSchedule")
+	assert.NoError(t, err)
+
+	node := &apiv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "hollow", Labels: map[string]string{"name": "hollow"}}}
+	updated := options.template.missingLabelsAndTaints(node)
+	assert.NotNil(t, updated)
+	assert.Equal(t, map[string]string{"name": "hollow", "tier": "batch"}, updated.Labels)
+	assert.Equal(t, options.template.taints, updated.Spec.Taints)
+	assert.Equal(t, map[string]string{"name": "hollow"}, node.Labels)
+	assert.Empty(t, node.Spec.Taints)
+
+	assert.Nil(t, options.template.missingLabelsAndTaints(updated))
+}
@@ -19,19 +19,34 @@ package magnum
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/magnum/gophercloud/openstack/containerinfra/v1/nodegroups"
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
 )
 
 const (
 	// Constants in the node group autodiscovery configuration string.
-	autoDiscovererTypeMagnum    = "magnum"
-	magnumAutoDiscovererKeyRole = "role"
+	autoDiscovererTypeMagnum      = "magnum"
+	magnumAutoDiscovererKeyRole   = "role"
+	magnumAutoDiscovererKeyLabel  = "label"
+	magnumAutoDiscovererKeyFlavor = "flavor"
+	magnumAutoDiscovererKeyImage  = "image"
+
+	// Magnum node group labels overriding the min/max node count of discovered node groups.
+	magnumAutoscalerMinLabel = "autoscaler/min"
+	magnumAutoscalerMaxLabel = "autoscaler/max"
 )
 
 type magnumAutoDiscoveryConfig struct {
 	Roles []string
+	// Labels the node group must have. An empty value only requires the label key to be present.
+	Labels  map[string]string
+	Flavors []string
+	Images  []string
 }
 
 func parseMagnumAutoDiscoverySpecs(o cloudprovider.NodeGroupDiscoveryOptions) ([]magnumAutoDiscoveryConfig, error) {
@@ -50,14 +65,16 @@ func parseMagnumAutoDiscoverySpecs(o cloudprovider.NodeGroupDiscoveryOptions) ([
 // and parses it into an auto discovery config.
 //
 // The spec format is:
-// magnum:role=<role>[,<role2>]
+// magnum:role=<role>[,<role2>][:label=<key>[=<value>]][:flavor=<flavor>[,<flavor2>]][:image=<image>[,<image2>]]
+//
+// The label parameter can be given multiple times. At least one parameter is required.
 func parseMagnumAutoDiscoverySpec(spec string) (magnumAutoDiscoveryConfig, error) {
 	cfg := magnumAutoDiscoveryConfig{}
 
-	// Split the spec into two parts, the discoverer (magnum)
-	// and the discovery parameter (role=value).
+	// Split the spec into the discoverer (magnum)
+	// and the discovery parameters (key=value).
 	tokens := strings.Split(spec, ":")
-	if len(tokens) != 2 {
+	if len(tokens) < 2 {
 		return cfg, fmt.Errorf("invalid node group auto discovery spec specified via --node-group-auto-discovery: %s", spec)
 	}
 	discoverer := tokens[0]
@@ -65,36 +82,108 @@ func parseMagnumAutoDiscoverySpec(spec string) (magnumAutoDiscoveryConfig, error
 		return cfg, fmt.Errorf("unsupported discoverer specified: %s", discoverer)
 	}
 
-	// Split the discovery parameter into a key value pair.
-	param := tokens[1]
-	kv := strings.SplitN(param, "=", 2)
-	if len(kv) != 2 {
-		return cfg, fmt.Errorf("invalid discovery key=value pair %s", kv)
-	}
+	for _, param := range tokens[1:] {
+		// Split the discovery parameter into a key value pair.
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return cfg, fmt.Errorf("invalid discovery key=value pair %s", kv)
+		}
 
-	k, v := kv[0], kv[1]
-	if k != magnumAutoDiscovererKeyRole {
-		return cfg, fmt.Errorf("unsupported parameter key %q is specified for discoverer %q. The only supported key is %q", k, discoverer, magnumAutoDiscovererKeyRole)
+		k, v := kv[0], kv[1]
+		switch k {
+		case magnumAutoDiscovererKeyRole:
+			roles, err := parseAutoDiscoveryList(k, v)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Roles = append(cfg.Roles, roles...)
+		case magnumAutoDiscovererKeyFlavor:
+			flavors, err := parseAutoDiscoveryList(k, v)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Flavors = append(cfg.Flavors, flavors...)
+		case magnumAutoDiscovererKeyImage:
+			images, err := parseAutoDiscoveryList(k, v)
+			if err != nil {
+				return cfg, err
+			}
+			cfg.Images = append(cfg.Images, images...)
+		case magnumAutoDiscovererKeyLabel:
+			label := strings.SplitN(v, "=", 2)
+			if label[0] == "" {
+				return cfg, errors.New("label key not supplied")
+			}
+			if cfg.Labels == nil {
+				cfg.Labels = make(map[string]string)
+			}
+			cfg.Labels[label[0]] = ""
+			if len(label) == 2 {
+				cfg.Labels[label[0]] = label[1]
+			}
+		default:
+			return cfg, fmt.Errorf("unsupported parameter key %q is specified for discoverer %q. The supported keys are %q, %q, %q and %q",
+				k, discoverer, magnumAutoDiscovererKeyRole, magnumAutoDiscovererKeyLabel, magnumAutoDiscovererKeyFlavor, magnumAutoDiscovererKeyImage)
+		}
 	}
 
-	if v == "" {
-		return cfg, errors.New("role value not supplied")
-	}
+	return cfg, nil
+}
 
-	// Allow specifying multiple roles in a single spec, comma separated.
-	roles := strings.Split(v, ",")
-	if len(roles) == 0 {
-		return cfg, errors.New("no roles specified")
+// parseAutoDiscoveryList parses the comma separated values of a discovery parameter.
+func parseAutoDiscoveryList(key, value string) ([]string, error) {
+	if value == "" {
+		return nil, fmt.Errorf("%s value not supplied", key)
 	}
 
-	// Check that all roles are valid.
-	for _, r := range roles {
-		if len(r) == 0 {
-			return cfg, fmt.Errorf("invalid role for auto discovery specified: role must not be empty")
+	// Allow specifying multiple values in a single spec, comma separated.
+	values := strings.Split(value, ",")
+
+	// Check that all values are valid.
+	for _, v := range values {
+		if len(v) == 0 {
+			return nil, fmt.Errorf("invalid %s for auto discovery specified: %s must not be empty", key, key)
 		}
 	}
+	return values, nil
+}
 
-	cfg.Roles = roles
+// matches returns whether the node group meets all conditions of the config.
+func (cfg magnumAutoDiscoveryConfig) matches(ng *nodegroups.NodeGroup) bool {
+	if len(cfg.Roles) > 0 && !slices.Contains(cfg.Roles, ng.Role) {
+		return false
+	}
+	if len(cfg.Flavors) > 0 && !slices.Contains(cfg.Flavors, ng.FlavorID) {
+		return false
+	}
+	if len(cfg.Images) > 0 && !slices.Contains(cfg.Images, ng.ImageID) {
+		return false
+	}
+	for key, value := range cfg.Labels {
+		actual, found := ng.Labels[key]
+		if !found || (value != "" && actual != value) {
+			return false
+		}
+	}
+	return true
+}
 
-	return cfg, nil
+// applyNodeCountLabels overrides the min/max node count of a node group with
+// the values of its autoscaler/min and autoscaler/max labels. Invalid values
+// are ignored.
+func applyNodeCountLabels(ng *nodegroups.NodeGroup) {
+	if value, found := ng.Labels[magnumAutoscalerMinLabel]; found {
+		if minSize, err := strconv.Atoi(value); err == nil && minSize >= 0 {
+			ng.MinNodeCount = minSize
+		} else {
+			klog.Warningf("Ignoring invalid %s label %q of node group %s", magnumAutoscalerMinLabel, value, ng.Name)
+		}
+	}
+	if value, found := ng.Labels[magnumAutoscalerMaxLabel]; found {
+		if maxSize, err := strconv.Atoi(value); err == nil && maxSize >= 0 {
+			ng.MaxNodeCount = &maxSize
+		} else {
+			klog.Warningf("Ignoring invalid %s label %q of node group %s", magnumAutoscalerMaxLabel, value, ng.Name)
+		}
+	}
 }
@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/magnum/gophercloud/openstack/containerinfra/v1/nodegroups"
 )
 
 func TestParseAutoDiscoverySpec(t *testing.T) {
@@ -41,6 +44,13 @@ func TestParseAutoDiscoverySpec(t *testing.T) {
 
 		{Spec: "abc:role=autoscaling", Roles: nil, Err: true},
 		{Spec: "magnum:abc=autoscaling", Roles: nil, Err: true},
+
+		{Spec: "magnum:role=worker:flavor=m1.large", Roles: []string{"worker"}, Err: false},
+		{Spec: "magnum:flavor=m1.large", Roles: nil, Err: false},
+		{Spec: "magnum:role=worker:", Roles: nil, Err: true},
+		{Spec: "magnum:role=worker:flavor=", Roles: nil, Err: true},
+		{Spec: "magnum:role=worker:image=a,,b", Roles: nil, Err: true},
+		{Spec: "magnum:label==value", Roles: nil, Err: true},
 	}
 
 	for _, s := range specs {
@@ -53,3 +63,67 @@ func TestParseAutoDiscoverySpec(t *testing.T) {
 		assert.Equal(t, s.Roles, cfg.Roles)
 	}
 }
+
+func TestParseAutoDiscoverySpecFilters(t *testing.T) {
+	cfg, err := parseMagnumAutoDiscoverySpec("magnum:role=worker:label=tenant=a:label=gpu:flavor=m1.large,m1.xlarge:image=fedora-coreos-38")
+	require.NoError(t, err)
+	assert.Equal(t, magnumAutoDiscoveryConfig{
+		Roles:   []string{"worker"},
+		Labels:  map[string]string{"tenant": "a", "gpu": ""},
+		Flavors: []string{"m1.large", "m1.xlarge"},
+		Images:  []string{"fedora-coreos-38"},
+	}, cfg)
+}
+
+func TestAutoDiscoveryConfigMatches(t *testing.T) {
+	ng := &nodegroups.NodeGroup{
+		Role:     "worker",
+		FlavorID: "m1.large",
+		ImageID:  "fedora-coreos-38",
+		Labels:   map[string]string{"tenant": "a", "gpu": "true"},
+	}
+
+	specs := []struct {
+		Spec    string
+		Matches bool
+	}{
+		{Spec: "magnum:role=worker", Matches: true},
+		{Spec: "magnum:role=autoscaling", Matches: false},
+		{Spec: "magnum:role=worker:flavor=m1.small,m1.large", Matches: true},
+		{Spec: "magnum:role=worker:flavor=m1.small", Matches: false},
+		{Spec: "magnum:image=fedora-coreos-38", Matches: true},
+		{Spec: "magnum:image=ubuntu", Matches: false},
+		{Spec: "magnum:label=tenant=a:label=gpu", Matches: true},
+		{Spec: "magnum:label=tenant=b", Matches: false},
+		{Spec: "magnum:label=zone", Matches: false},
+	}
+
+	for _, s := range specs {
+		cfg, err := parseMagnumAutoDiscoverySpec(s.Spec)
+		require.NoError(t, err)
+		assert.Equal(t, s.Matches, cfg.matches(ng), s.Spec)
+	}
+}
+
+func TestApplyNodeCountLabels(t *testing.T) {
+	five := 5
+
+	ng := &nodegroups.NodeGroup{
+		MinNodeCount: 1,
+		MaxNodeCount: nil,
+		Labels:       map[string]string{magnumAutoscalerMinLabel: "2", magnumAutoscalerMaxLabel: "10"},
+	}
+	applyNodeCountLabels(ng)
+	assert.Equal(t, 2, ng.MinNodeCount)
+	require.NotNil(t, ng.MaxNodeCount)
+	assert.Equal(t, 10, *ng.MaxNodeCount)
+
+	ng = &nodegroups.NodeGroup{
+		MinNodeCount: 1,
+		MaxNodeCount: &five,
+		Labels:       map[string]string{magnumAutoscalerMinLabel: "-1", magnumAutoscalerMaxLabel: "many"},
+	}
+	applyNodeCountLabels(ng)
+	assert.Equal(t, 1, ng.MinNodeCount)
+	assert.Equal(t, 5, *ng.MaxNodeCount)
+}
@@ -172,19 +172,26 @@ func (mgr *magnumManagerImpl) autoDiscoverNodeGroups(cfgs []magnumAutoDiscoveryC
 			return nil, fmt.Errorf("could not get detail for node group %s: %v", group.Name, err)
 		}
 
+		// The autoscaler/min and autoscaler/max labels take precedence
+		// over the node counts of the node group.
+		applyNodeCountLabels(detail)
+
 		// Max node count must be set to be eligible for autoscaling.
 		if detail.MaxNodeCount == nil {
 			klog.V(4).Infof("Node group %s does not have max node count set", detail.Name)
 			continue
 		}
+		if detail.MinNodeCount > *detail.MaxNodeCount {
+			klog.Warningf("Node group %s has min node count %d larger than max node count %d", detail.Name, detail.MinNodeCount, *detail.MaxNodeCount)
+			continue
+		}
 
 		// The group must match at least one auto discovery config.
 		var matchesAny bool
 		for _, cfg := range cfgs {
-			for _, role := range cfg.Roles {
-				if detail.Role == role {
-					matchesAny = true
-				}
+			if cfg.matches(detail) {
+				matchesAny = true
+				break
 			}
 		}
 		if !matchesAny {
@@ -26,6 +26,20 @@ type OciRef struct {
 	IsNodeSelfManaged  bool
 }
 
+// PoolType returns the resource type of the pool the referenced node belongs to, i.e. node pool or
+// instance pool, or an empty string if the node isn't known to belong to any pool.
+func (ref OciRef) PoolType() string {
+	poolID := ref.NodePoolID
+	if poolID == "" {
+		poolID = ref.InstancePoolID
+	}
+	poolType, err := GetPoolType(poolID)
+	if err != nil {
+		return ""
+	}
+	return poolType
+}
+
 // NodeToOciRef converts a node object into an oci reference
 func NodeToOciRef(n *apiv1.Node) (OciRef, error) {
 
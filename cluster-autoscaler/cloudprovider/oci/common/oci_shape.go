@@ -59,6 +59,23 @@ type Shape struct {
 	EphemeralStorageInBytes float32
 }
 
+// CreateShapeClient creates the compute clients used to fetch shapes with the given configuration provider.
+func CreateShapeClient(configProvider common.ConfigurationProvider, clientConfig common.CustomClientConfiguration) (ShapeClient, error) {
+	computeMgmtClient, err := core.NewComputeManagementClientWithConfigurationProvider(configProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create compute management client")
+	}
+	computeMgmtClient.SetCustomClientConfiguration(clientConfig)
+
+	computeClient, err := core.NewComputeClientWithConfigurationProvider(configProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create compute client")
+	}
+	computeClient.SetCustomClientConfiguration(clientConfig)
+
+	return ShapeClientImpl{ComputeMgmtClient: computeMgmtClient, ComputeClient: computeClient}, nil
+}
+
 // CreateShapeGetter creates a new oci shape getter.
 func CreateShapeGetter(shapeClient ShapeClient) ShapeGetter {
 	return &shapeGetterImpl{
@@ -75,6 +92,9 @@ type shapeGetterImpl struct {
 
 // Refresh clears out the cache to be populated again as the pool shapes are re-requested
 func (osf *shapeGetterImpl) Refresh() {
+	osf.mu.Lock()
+	defer osf.mu.Unlock()
+
 	// For now, just clear the cache
 	osf.cache = map[string]*Shape{}
 }
@@ -144,6 +164,8 @@ func (osf *shapeGetterImpl) GetNodePoolShape(np *oke.NodePool, ephemeralStorage
 
 // GetInstancePoolShape gets the shape by querying the instance pool's configuration
 func (osf *shapeGetterImpl) GetInstancePoolShape(ip *core.InstancePool) (*Shape, error) {
+	osf.mu.Lock()
+	defer osf.mu.Unlock()
 
 	// First, check instance pool shape cache
 	shape, ok := osf.cache[*ip.Id]
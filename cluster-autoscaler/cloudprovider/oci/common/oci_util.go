@@ -10,6 +10,7 @@ import (
 	"math"
 	"net"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -18,7 +19,11 @@ import (
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ipconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/instancepools/consts"
+	npconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools/consts"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/common"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/common/auth"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
 )
 
 // IsRetryable returns true if the given error is retryable.
@@ -49,6 +54,21 @@ func IsRetryable(err error) bool {
 	}
 }
 
+// CreateConfigurationProvider returns the provider of OCI credentials selected by the environment:
+// workload identity, instance principals or the default configuration provider.
+func CreateConfigurationProvider() (common.ConfigurationProvider, error) {
+	if os.Getenv(ipconsts.OciUseWorkloadIdentityEnvVar) == "true" {
+		klog.Info("using workload identity provider")
+		return auth.OkeWorkloadIdentityConfigurationProvider()
+	}
+	if os.Getenv(ipconsts.OciUseInstancePrincipalEnvVar) == "true" || os.Getenv(npconsts.OkeUseInstancePrincipalEnvVar) == "true" {
+		klog.Info("using instance principal provider")
+		return auth.InstancePrincipalConfigurationProvider()
+	}
+	klog.Info("using default configuration provider")
+	return common.DefaultConfigProvider(), nil
+}
+
 // NewRetryPolicy returns an exponential backoff retry policy
 func NewRetryPolicy() *common.RetryPolicy {
 	return NewRetryPolicyWithMaxAttempts(uint(8))
@@ -206,6 +226,43 @@ func GetAllPoolTypes(groups []string) (string, error) {
 	return ocidType, nil
 }
 
+// SplitNodeGroupDiscoveryOptions splits the node group specs and auto discovery specs into the ones for
+// instance pools and the ones for node pools, so both can be managed by the same autoscaler.
+func SplitNodeGroupDiscoveryOptions(do cloudprovider.NodeGroupDiscoveryOptions) (instancePools cloudprovider.NodeGroupDiscoveryOptions, nodePools cloudprovider.NodeGroupDiscoveryOptions, err error) {
+	instancePools, nodePools = do, do
+	instancePools.NodeGroupSpecs, nodePools.NodeGroupSpecs = nil, nil
+	instancePools.NodeGroupAutoDiscoverySpecs, nodePools.NodeGroupAutoDiscoverySpecs = nil, nil
+
+	for _, spec := range do.NodeGroupSpecs {
+		poolType, err := GetPoolType(spec)
+		if err != nil {
+			return instancePools, nodePools, err
+		}
+		switch poolType {
+		case ipconsts.OciInstancePoolResourceIdent:
+			instancePools.NodeGroupSpecs = append(instancePools.NodeGroupSpecs, spec)
+		case npconsts.OciNodePoolResourceIdent:
+			nodePools.NodeGroupSpecs = append(nodePools.NodeGroupSpecs, spec)
+		default:
+			return instancePools, nodePools, fmt.Errorf("unsupported ocid type %s of %s, only instance pools and node pools are supported", poolType, spec)
+		}
+	}
+
+	for _, spec := range do.NodeGroupAutoDiscoverySpecs {
+		instancePoolTagsFound, nodePoolTagsFound, err := HasNodeGroupTags([]string{spec})
+		if err != nil {
+			return instancePools, nodePools, err
+		}
+		if nodePoolTagsFound {
+			nodePools.NodeGroupAutoDiscoverySpecs = append(nodePools.NodeGroupAutoDiscoverySpecs, spec)
+		}
+		if instancePoolTagsFound {
+			instancePools.NodeGroupAutoDiscoverySpecs = append(instancePools.NodeGroupAutoDiscoverySpecs, spec)
+		}
+	}
+	return instancePools, nodePools, nil
+}
+
 // HasNodeGroupTags checks if nodepoolTags is provided
 func HasNodeGroupTags(nodeGroupAutoDiscoveryList []string) (bool, bool, error) {
 	instancePoolTagsFound := false
@@ -5,7 +5,10 @@ Copyright 2021-2023 Oracle and/or its affiliates.
 package common
 
 import (
+	"reflect"
 	"testing"
+
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
 )
 
 func TestSetProviderID(t *testing.T) {
@@ -14,3 +17,56 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestSplitNodeGroupDiscoveryOptions(t *testing.T) {
+	do := cloudprovider.NodeGroupDiscoveryOptions{
+		NodeGroupSpecs: []string{
+			"1:5:ocid1.instancepool.oc1.phx.aaa",
+			"0:3:ocid1.nodepool.oc1.phx.bbb",
+		},
+		NodeGroupAutoDiscoverySpecs: []string{
+			"clusterId:ocid1.cluster.oc1.phx.ccc,compartmentId:ocid1.compartment.oc1..ddd,nodepoolTags:ca-managed=true,min:1,max:5",
+			"compartmentId:ocid1.compartment.oc1..ddd,instancepoolTags:ca-managed=true,min:1,max:5",
+		},
+	}
+	instancePools, nodePools, err := SplitNodeGroupDiscoveryOptions(do)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"1:5:ocid1.instancepool.oc1.phx.aaa"}; !reflect.DeepEqual(instancePools.NodeGroupSpecs, want) {
+		t.Errorf("instance pool specs = %v, want %v", instancePools.NodeGroupSpecs, want)
+	}
+	if want := []string{"0:3:ocid1.nodepool.oc1.phx.bbb"}; !reflect.DeepEqual(nodePools.NodeGroupSpecs, want) {
+		t.Errorf("node pool specs = %v, want %v", nodePools.NodeGroupSpecs, want)
+	}
+	if want := do.NodeGroupAutoDiscoverySpecs[1:]; !reflect.DeepEqual(instancePools.NodeGroupAutoDiscoverySpecs, want) {
+		t.Errorf("instance pool auto discovery specs = %v, want %v", instancePools.NodeGroupAutoDiscoverySpecs, want)
+	}
+	if want := do.NodeGroupAutoDiscoverySpecs[:1]; !reflect.DeepEqual(nodePools.NodeGroupAutoDiscoverySpecs, want) {
+		t.Errorf("node pool auto discovery specs = %v, want %v", nodePools.NodeGroupAutoDiscoverySpecs, want)
+	}
+
+	for _, spec := range []string{"1:5:ocid1.cluster.oc1.phx.aaa", "1:5:invalid"} {
+		if _, _, err := SplitNodeGroupDiscoveryOptions(cloudprovider.NodeGroupDiscoveryOptions{NodeGroupSpecs: []string{spec}}); err == nil {
+			t.Errorf("expected error for spec %s", spec)
+		}
+	}
+}
+
+func TestOciRefPoolType(t *testing.T) {
+	tests := map[string]struct {
+		ref  OciRef
+		want string
+	}{
+		"node pool":     {ref: OciRef{NodePoolID: "ocid1.nodepool.oc1.phx.aaa"}, want: "nodepool"},
+		"instance pool": {ref: OciRef{InstancePoolID: "ocid1.instancepool.oc1.phx.aaa"}, want: "instancepool"},
+		"no pool":       {ref: OciRef{InstanceID: "ocid1.instance.oc1.phx.aaa"}, want: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.ref.PoolType(); got != tt.want {
+				t.Errorf("PoolType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,8 +5,6 @@ Copyright 2021-2023 Oracle and/or its affiliates.
 package instancepools
 
 import (
-	"strings"
-
 	"github.com/pkg/errors"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -19,9 +17,7 @@ import (
 	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider/builder"
 
 	ocicommon "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/common"
-	ipconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/instancepools/consts"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools"
-	npconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools/consts"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/common"
 	"sigs.k8s.io/cluster-autoscaler/pkg/config"
 	coreoptions "sigs.k8s.io/cluster-autoscaler/pkg/core/options"
@@ -171,39 +167,69 @@ func (ocp *OciCloudProvider) Refresh() error {
 }
 
 // BuildOCI constructs the OciCloudProvider object that implements the could provider interface (InstancePoolManager).
+// If both instance pools and node pools are configured, an OciCompositeCloudProvider managing both is returned.
 func BuildOCI(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDiscoveryOptions, rl *cloudprovider.ResourceLimiter) cloudprovider.CloudProvider {
 	common.EnableInstanceMetadataServiceLookup()
-	ocidType, err := ocicommon.GetAllPoolTypes(do.NodeGroupSpecs)
+	instancePoolOpts, nodePoolOpts, err := ocicommon.SplitNodeGroupDiscoveryOptions(do)
 	if err != nil {
-		klog.Fatalf("Failed to get pool type: %v", err)
+		klog.Fatalf("Failed to get pool types: %v", err)
+	}
+	if len(nodePoolOpts.NodeGroupSpecs) > 0 && len(nodePoolOpts.NodeGroupAutoDiscoverySpecs) > 0 {
+		klog.Fatalf("-nodes and -node-group-auto-discovery parameters can not be used together for nodepools.")
 	}
-	instancepoolTagsFound, nodepoolTagsFound, err := ocicommon.HasNodeGroupTags(do.NodeGroupAutoDiscoverySpecs)
+	if len(instancePoolOpts.NodeGroupSpecs) > 0 && len(instancePoolOpts.NodeGroupAutoDiscoverySpecs) > 0 {
+		klog.Fatalf("-nodes and -node-group-auto-discovery parameters can not be used together for instancepools.")
+	}
+	hasNodePools := len(nodePoolOpts.NodeGroupSpecs) > 0 || len(nodePoolOpts.NodeGroupAutoDiscoverySpecs) > 0
+	hasInstancePools := len(instancePoolOpts.NodeGroupSpecs) > 0 || len(instancePoolOpts.NodeGroupAutoDiscoverySpecs) > 0
+
+	// Both managers share one shape getter, so that a shape is only fetched once per refresh.
+	shapeGetter, err := createShapeGetter()
 	if err != nil {
-		klog.Fatalf("Failed to get auto discovery tags: %v", err)
+		klog.Fatalf("Could not create OCI shape getter: %v", err)
 	}
-	if strings.HasPrefix(ocidType, npconsts.OciNodePoolResourceIdent) && nodepoolTagsFound {
-		klog.Fatalf("-nodes and -node-group-auto-discovery parameters can not be used together for nodepools.")
-	} else if strings.HasPrefix(ocidType, npconsts.OciNodePoolResourceIdent) || nodepoolTagsFound {
-		manager, err := nodepools.CreateNodePoolManager(opts.CloudConfig, do.NodeGroupAutoDiscoverySpecs, do, createKubeClient(opts.AutoscalingOptions))
+
+	var nodePoolProvider *nodepools.OciCloudProvider
+	if hasNodePools {
+		manager, err := nodepools.CreateNodePoolManager(opts.CloudConfig, nodePoolOpts.NodeGroupAutoDiscoverySpecs, nodePoolOpts, createKubeClient(opts.AutoscalingOptions), shapeGetter)
 		if err != nil {
 			klog.Fatalf("Could not create OCI OKE cloud provider: %v", err)
 		}
-		return nodepools.NewOciCloudProvider(manager, rl)
+		nodePoolProvider = nodepools.NewOciCloudProvider(manager, rl)
+		if !hasInstancePools {
+			return nodePoolProvider
+		}
 	}
 
 	// Theoretically, the only other possible value is no value (if no node groups are passed in)
 	// or instancepool, but either way, we'll just default to the instance pool implementation
-	if strings.HasPrefix(ocidType, ipconsts.OciInstancePoolResourceIdent) && instancepoolTagsFound {
-		klog.Fatalf("-nodes and -node-group-auto-discovery parameters can not be used together for instancepools.")
-	}
-	ipManager, err := CreateInstancePoolManager(opts.CloudConfig, do, createKubeClient(opts.AutoscalingOptions))
+	ipManager, err := CreateInstancePoolManager(opts.CloudConfig, instancePoolOpts, createKubeClient(opts.AutoscalingOptions), shapeGetter)
 	if err != nil {
 		klog.Fatalf("Could not create OCI cloud provider: %v", err)
 	}
-	return &OciCloudProvider{
+	instancePoolProvider := &OciCloudProvider{
 		poolManager: ipManager,
 		rl:          rl,
 	}
+	if nodePoolProvider != nil {
+		klog.Infof("Managing OCI instance pools and OKE node pools together")
+		return NewOciCompositeCloudProvider(instancePoolProvider, nodePoolProvider, rl)
+	}
+	return instancePoolProvider
+}
+
+func createShapeGetter() (ocicommon.ShapeGetter, error) {
+	configProvider, err := ocicommon.CreateConfigurationProvider()
+	if err != nil {
+		return nil, err
+	}
+	shapeClient, err := ocicommon.CreateShapeClient(configProvider, common.CustomClientConfiguration{
+		RetryPolicy: ocicommon.NewRetryPolicy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ocicommon.CreateShapeGetter(shapeClient), nil
 }
 
 func getKubeConfig(opts config.AutoscalingOptions) *rest.Config {
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 Oracle and/or its affiliates.
+*/
+
+package instancepools
+
+import (
+	"errors"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+
+	ocicommon "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/common"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools"
+	npconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools/consts"
+	caerrors "sigs.k8s.io/cluster-autoscaler/pkg/utils/errors"
+	"sigs.k8s.io/cluster-autoscaler/pkg/utils/gpu"
+)
+
+// OciCompositeCloudProvider implements the CloudProvider interface for clusters that use both OKE node
+// pools and self-managed instance pools. It merges the node groups of both providers and routes nodes
+// to one of them based on the type of the pool OCID found on the node.
+type OciCompositeCloudProvider struct {
+	rl            *cloudprovider.ResourceLimiter
+	instancePools *OciCloudProvider
+	nodePools     *nodepools.OciCloudProvider
+}
+
+// NewOciCompositeCloudProvider creates a CloudProvider that manages instance pools and node pools together.
+func NewOciCompositeCloudProvider(instancePools *OciCloudProvider, nodePools *nodepools.OciCloudProvider, rl *cloudprovider.ResourceLimiter) *OciCompositeCloudProvider {
+	return &OciCompositeCloudProvider{
+		rl:            rl,
+		instancePools: instancePools,
+		nodePools:     nodePools,
+	}
+}
+
+// Name returns name of the cloud provider.
+func (ocp *OciCompositeCloudProvider) Name() string {
+	return ProviderName
+}
+
+// NodeGroups returns all node groups configured for this cloud provider.
+func (ocp *OciCompositeCloudProvider) NodeGroups() []cloudprovider.NodeGroup {
+	return append(ocp.instancePools.NodeGroups(), ocp.nodePools.NodeGroups()...)
+}
+
+// providerForNode returns the provider that manages the pool the node belongs to. Nodes without a
+// pool OCID are handed to the instance pool provider, which can also find them by instance id.
+func (ocp *OciCompositeCloudProvider) providerForNode(n *apiv1.Node) (cloudprovider.CloudProvider, error) {
+	ociRef, err := ocicommon.NodeToOciRef(n)
+	if err != nil {
+		return nil, err
+	}
+	if ociRef.PoolType() == npconsts.OciNodePoolResourceIdent {
+		klog.V(5).Infof("node %s belongs to node pool %s", n.Name, ociRef.NodePoolID)
+		return ocp.nodePools, nil
+	}
+	return ocp.instancePools, nil
+}
+
+// NodeGroupForNode returns the node group for the given node, nil if the node
+// should not be processed by cluster autoscaler, or non-nil error if such
+// occurred. Must be implemented.
+func (ocp *OciCompositeCloudProvider) NodeGroupForNode(n *apiv1.Node) (cloudprovider.NodeGroup, error) {
+	provider, err := ocp.providerForNode(n)
+	if err != nil {
+		return nil, err
+	}
+	return provider.NodeGroupForNode(n)
+}
+
+// HasInstance returns whether a given node has a corresponding instance in this cloud provider
+func (ocp *OciCompositeCloudProvider) HasInstance(node *apiv1.Node) (bool, error) {
+	provider, err := ocp.providerForNode(node)
+	if err != nil {
+		return false, err
+	}
+	return provider.HasInstance(node)
+}
+
+// Pricing returns pricing model for this cloud provider or error if not available.
+// Implementation optional.
+func (ocp *OciCompositeCloudProvider) Pricing() (cloudprovider.PricingModel, caerrors.AutoscalerError) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+// GetAvailableMachineTypes get all machine types that can be requested from the cloud provider.
+// Implementation optional.
+func (ocp *OciCompositeCloudProvider) GetAvailableMachineTypes() ([]string, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+// NewNodeGroup builds a theoretical node group based on the node definition provided. The node group is not automatically
+// created on the cloud provider side. The node group is not returned by NodeGroups() until it is created.
+// Implementation optional.
+func (ocp *OciCompositeCloudProvider) NewNodeGroup(machineType string,
+	labels map[string]string,
+	systemLabels map[string]string,
+	taints []apiv1.Taint,
+	extraResources map[string]resource.Quantity,
+) (cloudprovider.NodeGroup, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+// GetResourceLimiter returns struct containing limits (max, min) for resources (cores, memory etc.).
+func (ocp *OciCompositeCloudProvider) GetResourceLimiter() (*cloudprovider.ResourceLimiter, error) {
+	return ocp.rl, nil
+}
+
+// GPULabel returns the label added to nodes with GPU resource.
+func (ocp *OciCompositeCloudProvider) GPULabel() string {
+	return ""
+}
+
+// GetAvailableGPUTypes return all available GPU types cloud provider supports.
+func (ocp *OciCompositeCloudProvider) GetAvailableGPUTypes() map[string]struct{} {
+	return map[string]struct{}{}
+}
+
+// GetNodeGpuConfig returns the label, type and resource name for the GPU added to node. If node doesn't have
+// any GPUs, it returns nil.
+func (ocp *OciCompositeCloudProvider) GetNodeGpuConfig(node *apiv1.Node) *cloudprovider.GpuConfig {
+	return gpu.GetNodeGPUFromCloudProvider(ocp, node)
+}
+
+// Cleanup cleans up open resources before the cloud provider is destroyed, i.e. go routines etc.
+func (ocp *OciCompositeCloudProvider) Cleanup() error {
+	return errors.Join(ocp.instancePools.Cleanup(), ocp.nodePools.Cleanup())
+}
+
+// Refresh is called before every main loop and can be used to dynamically update cloud provider state.
+// In particular the list of node groups returned by NodeGroups can change as a result of CloudProvider.Refresh().
+func (ocp *OciCompositeCloudProvider) Refresh() error {
+	return errors.Join(ocp.instancePools.Refresh(), ocp.nodePools.Refresh())
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 Oracle and/or its affiliates.
+*/
+
+package instancepools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+
+	ocicommon "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/common"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/instancepools/consts"
+	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools"
+)
+
+const (
+	testInstancePoolID = "ocid1.instancepool.oc1.phx.aaaaaaaa1"
+	testNodePoolID     = "ocid1.nodepool.oc1.phx.aaaaaaaa1"
+)
+
+// fakeInstancePoolManager serves the instance pools it holds and records the instances it's asked about.
+type fakeInstancePoolManager struct {
+	InstancePoolManager
+	instancePools []*InstancePoolNodeGroup
+	lookups       []string
+}
+
+func (m *fakeInstancePoolManager) GetInstancePools() []*InstancePoolNodeGroup {
+	return m.instancePools
+}
+
+func (m *fakeInstancePoolManager) GetInstancePoolForInstance(instance ocicommon.OciRef) (*InstancePoolNodeGroup, error) {
+	m.lookups = append(m.lookups, instance.Name)
+	for _, ip := range m.instancePools {
+		if ip.Id() == instance.InstancePoolID {
+			return ip, nil
+		}
+	}
+	return nil, errInstanceInstancePoolNotFound
+}
+
+type fakeNodePool struct {
+	cloudprovider.NodeGroup
+	id string
+}
+
+func (np *fakeNodePool) Id() string {
+	return np.id
+}
+
+// fakeNodePoolManager serves the node pools it holds and records the instances it's asked about.
+type fakeNodePoolManager struct {
+	nodepools.NodePoolManager
+	nodePools []nodepools.NodePool
+	lookups   []string
+}
+
+func (m *fakeNodePoolManager) GetNodePools() []nodepools.NodePool {
+	return m.nodePools
+}
+
+func (m *fakeNodePoolManager) GetNodePoolForInstance(instance ocicommon.OciRef) (nodepools.NodePool, error) {
+	m.lookups = append(m.lookups, instance.Name)
+	for _, np := range m.nodePools {
+		if np.Id() == instance.NodePoolID {
+			return np, nil
+		}
+	}
+	return nil, nil
+}
+
+func newTestCompositeCloudProvider() (*OciCompositeCloudProvider, *fakeInstancePoolManager, *fakeNodePoolManager) {
+	ipManager := &fakeInstancePoolManager{
+		instancePools: []*InstancePoolNodeGroup{{id: testInstancePoolID}},
+	}
+	npManager := &fakeNodePoolManager{
+		nodePools: []nodepools.NodePool{&fakeNodePool{id: testNodePoolID}},
+	}
+	provider := NewOciCompositeCloudProvider(
+		&OciCloudProvider{poolManager: ipManager},
+		nodepools.NewOciCloudProvider(npManager, nil),
+		nil)
+	return provider, ipManager, npManager
+}
+
+func TestCompositeNodeGroups(t *testing.T) {
+	provider, _, _ := newTestCompositeCloudProvider()
+
+	var ids []string
+	for _, ng := range provider.NodeGroups() {
+		ids = append(ids, ng.Id())
+	}
+	require.Equal(t, []string{testInstancePoolID, testNodePoolID}, ids)
+}
+
+func TestCompositeNodeGroupForNode(t *testing.T) {
+	testCases := []struct {
+		name               string
+		annotations        map[string]string
+		wantNodeGroup      string
+		wantInstanceLookup bool
+		wantNodePoolLookup bool
+	}{
+		{
+			name:               "instance pool node",
+			annotations:        map[string]string{consts.OciInstancePoolIDAnnotation: testInstancePoolID},
+			wantNodeGroup:      testInstancePoolID,
+			wantInstanceLookup: true,
+		},
+		{
+			name:               "node pool node",
+			annotations:        map[string]string{"oci.oraclecloud.com/node-pool-id": testNodePoolID},
+			wantNodeGroup:      testNodePoolID,
+			wantNodePoolLookup: true,
+		},
+		{
+			name:               "node of an unmanaged node pool",
+			annotations:        map[string]string{"oci.oraclecloud.com/node-pool-id": "ocid1.nodepool.oc1.phx.aaaaaaaa2"},
+			wantNodePoolLookup: true,
+		},
+		{
+			name:               "node of an unmanaged instance pool",
+			annotations:        map[string]string{consts.OciInstancePoolIDAnnotation: "ocid1.instancepool.oc1.phx.aaaaaaaa2"},
+			wantInstanceLookup: true,
+		},
+		{
+			name:               "node without a pool",
+			wantInstanceLookup: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider, ipManager, npManager := newTestCompositeCloudProvider()
+			node := &apiv1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node",
+					Annotations: tc.annotations,
+				},
+				Spec: apiv1.NodeSpec{ProviderID: "oci://ocid1.instance.oc1.phx.aaaaaaaa1"},
+			}
+
+			ng, err := provider.NodeGroupForNode(node)
+			require.NoError(t, err)
+			if tc.wantNodeGroup == "" {
+				require.Nil(t, ng)
+			} else {
+				require.NotNil(t, ng)
+				require.Equal(t, tc.wantNodeGroup, ng.Id())
+			}
+			require.Equal(t, tc.wantInstanceLookup, len(ipManager.lookups) > 0, "instance pool lookups: %v", ipManager.lookups)
+			require.Equal(t, tc.wantNodePoolLookup, len(npManager.lookups) > 0, "node pool lookups: %v", npManager.lookups)
+		})
+	}
+}
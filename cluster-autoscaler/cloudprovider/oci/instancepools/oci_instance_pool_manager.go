@@ -7,7 +7,6 @@ package instancepools
 import (
 	"context"
 	"fmt"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -15,7 +14,6 @@ import (
 
 	ocicommon "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/common"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/instancepools/consts"
-	npconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools/consts"
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -28,7 +26,6 @@ import (
 
 	"github.com/pkg/errors"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/common"
-	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/common/auth"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/core"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/workrequests"
 )
@@ -85,33 +82,16 @@ type InstancePoolManagerImpl struct {
 }
 
 // CreateInstancePoolManager constructs the InstancePoolManager object.
-func CreateInstancePoolManager(cloudConfigPath string, discoveryOpts cloudprovider.NodeGroupDiscoveryOptions, kubeClient kubernetes.Interface) (InstancePoolManager, error) {
-
-	var err error
-	var configProvider common.ConfigurationProvider
+// The shape getter is shared with the node pool manager when both pool types are managed.
+func CreateInstancePoolManager(cloudConfigPath string, discoveryOpts cloudprovider.NodeGroupDiscoveryOptions, kubeClient kubernetes.Interface, shapeGetter ocicommon.ShapeGetter) (InstancePoolManager, error) {
 
 	clientConfig := common.CustomClientConfiguration{
 		RetryPolicy: ocicommon.NewRetryPolicy(),
 	}
 
-	// Preference to Workload Identity if set to true
-	if os.Getenv(consts.OciUseWorkloadIdentityEnvVar) == "true" {
-		klog.V(4).Info("using workload identity...")
-		configProvider, err = auth.OkeWorkloadIdentityConfigurationProvider()
-		if err != nil {
-			return nil, err
-		}
-		// try instance principal is set to true
-	} else if os.Getenv(consts.OciUseInstancePrincipalEnvVar) == "true" || os.Getenv(npconsts.OkeUseInstancePrincipalEnvVar) == "true" {
-		klog.V(4).Info("using instance principals...")
-		configProvider, err = auth.InstancePrincipalConfigurationProvider()
-		if err != nil {
-			return nil, err
-		}
-		// default to default provider
-	} else {
-		klog.Info("using default configuration provider")
-		configProvider = common.DefaultConfigProvider()
+	configProvider, err := ocicommon.CreateConfigurationProvider()
+	if err != nil {
+		return nil, err
 	}
 	providerRegion, _ := configProvider.Region()
 	klog.Infof("OCI provider region: %s ", providerRegion)
@@ -149,7 +129,7 @@ func CreateInstancePoolManager(cloudConfigPath string, discoveryOpts cloudprovid
 		cfg:                     cloudConfig,
 		computeManagementClient: &computeMgmtClient,
 		staticInstancePools:     map[string]*InstancePoolNodeGroup{},
-		ShapeGetter:             ocicommon.CreateShapeGetter(ocicommon.ShapeClientImpl{ComputeMgmtClient: computeMgmtClient, ComputeClient: computeClient}),
+		ShapeGetter:             shapeGetter,
 		tagsGetter:              ocicommon.CreateTagsGetter(),
 		instancePoolCache:       newInstancePoolCache(&computeMgmtClient, &computeClient, &networkClient, &workRequestClient),
 		kubeClient:              kubeClient,
@@ -213,7 +213,7 @@ func TestCreateInstancePoolManagerRejectsMixedDiscovery(t *testing.T) {
 	_, err := CreateInstancePoolManager("", cloudprovider.NodeGroupDiscoveryOptions{
 		NodeGroupSpecs:              []string{"1:5:ocid1.instancepool.oc1.phx.aaaaaaaah"},
 		NodeGroupAutoDiscoverySpecs: []string{"compartmentId:ocid1.compartment.oc1..aaaaaaaa1,instancepoolTags:env=prod,min:1,max:5"},
-	}, nil)
+	}, nil, nil)
 	require.Error(t, err, "expected error when both static and auto-discovery specs are provided")
 }
 
@@ -27,7 +27,6 @@ import (
 	ipconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/instancepools/consts"
 	npconsts "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/nodepools/consts"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/common"
-	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/common/auth"
 	oke "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/containerengine"
 	"k8s.io/autoscaler/cluster-autoscaler/cloudprovider/oci/vendor-internal/github.com/oracle/oci-go-sdk/v65/core"
 )
@@ -87,30 +86,16 @@ type okeClient interface {
 	ListNodePools(ctx context.Context, request oke.ListNodePoolsRequest) (oke.ListNodePoolsResponse, error)
 }
 
-// CreateNodePoolManager creates an NodePoolManager that can manage autoscaling node pools
-func CreateNodePoolManager(cloudConfigPath string, nodeGroupAutoDiscoveryList []string, discoveryOpts cloudprovider.NodeGroupDiscoveryOptions, kubeClient kubernetes.Interface) (NodePoolManager, error) {
-
-	var err error
-	var configProvider common.ConfigurationProvider
+// CreateNodePoolManager creates an NodePoolManager that can manage autoscaling node pools.
+// The shape getter is shared with the instance pool manager when both pool types are managed.
+func CreateNodePoolManager(cloudConfigPath string, nodeGroupAutoDiscoveryList []string, discoveryOpts cloudprovider.NodeGroupDiscoveryOptions, kubeClient kubernetes.Interface, ociShapeGetter ocicommon.ShapeGetter) (NodePoolManager, error) {
 
 	// enable SDK to look up the IMDS endpoint to figure out the right realmDomain
 	common.EnableInstanceMetadataServiceLookup()
 
-	if os.Getenv(ipconsts.OciUseWorkloadIdentityEnvVar) == "true" {
-		klog.Info("using workload identity provider")
-		configProvider, err = auth.OkeWorkloadIdentityConfigurationProvider()
-		if err != nil {
-			return nil, err
-		}
-	} else if os.Getenv(ipconsts.OciUseInstancePrincipalEnvVar) == "true" || os.Getenv(npconsts.OkeUseInstancePrincipalEnvVar) == "true" {
-		klog.Info("using instance principal provider")
-		configProvider, err = auth.InstancePrincipalConfigurationProvider()
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		klog.Info("using default configuration provider")
-		configProvider = common.DefaultConfigProvider()
+	configProvider, err := ocicommon.CreateConfigurationProvider()
+	if err != nil {
+		return nil, err
 	}
 
 	cloudConfig, err := ocicommon.CreateCloudConfig(cloudConfigPath, configProvider, npconsts.OciNodePoolResourceIdent)
@@ -134,21 +119,12 @@ func CreateNodePoolManager(cloudConfigPath string, nodeGroupAutoDiscoveryList []
 		okeClient.BaseClient.Host = os.Getenv(npconsts.OkeHostOverrideEnvVar)
 	}
 
-	// node pools don't need this, but set it anyway
-	computeMgmtClient, err := core.NewComputeManagementClientWithConfigurationProvider(configProvider)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to create compute management client")
-	}
-	computeMgmtClient.SetCustomClientConfiguration(clientConfig)
-
 	computeClient, err := core.NewComputeClientWithConfigurationProvider(configProvider)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create compute client")
 	}
 	computeClient.SetCustomClientConfiguration(clientConfig)
 
-	//ociShapeGetter := ocicommon.CreateShapeGetter(computeClient)
-	ociShapeGetter := ocicommon.CreateShapeGetter(ocicommon.ShapeClientImpl{ComputeMgmtClient: computeMgmtClient, ComputeClient: computeClient})
 	ociTagsGetter := ocicommon.CreateTagsGetter()
 
 	registeredTaintsGetter := CreateRegisteredTaintsGetter()
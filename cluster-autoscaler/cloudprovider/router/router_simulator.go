@@ -1,5 +1,8 @@
+//go:build simulator
+// +build simulator
+
 /*
-Copyright 2019 The Kubernetes Authors.
+Copyright The Kubernetes Authors.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -14,16 +17,10 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package hetzner
+package router
 
 import (
-	"k8s.io/klog/v2"
+	// Blank import to register a cloudprovider outside main or test package.
+	// This is by design.
+	_ "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/simulator"
 )
-
-// debugWriter is a writer that logs to klog at level 5.
-type debugWriter struct{}
-
-func (d debugWriter) Write(p []byte) (n int, err error) {
-	klog.V(5).Info(string(p))
-	return len(p), nil
-}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider/builder"
+	coreoptions "sigs.k8s.io/cluster-autoscaler/pkg/core/options"
+	caerrors "sigs.k8s.io/cluster-autoscaler/pkg/utils/errors"
+	"sigs.k8s.io/cluster-autoscaler/pkg/utils/gpu"
+	kube_util "sigs.k8s.io/cluster-autoscaler/pkg/utils/kubernetes"
+)
+
+const (
+	// ProviderName is the cloud provider name for the simulator.
+	ProviderName = "simulator"
+
+	// GPULabel is the label holding the GPU type of fake nodes with GPUs.
+	GPULabel = "simulator.cluster-autoscaler.k8s.io/gpu-type"
+)
+
+func init() {
+	builder.RegisterCloudProvider(ProviderName, func(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDiscoveryOptions, rl *cloudprovider.ResourceLimiter, _ informers.SharedInformerFactory) cloudprovider.CloudProvider {
+		return BuildSimulator(opts, do, rl)
+	})
+	builder.SetDefaultCloudProvider(ProviderName)
+}
+
+// SimulatorCloudProvider implements CloudProvider for a simulated cloud driven
+// by a scenario. Instances of the cloud are fake nodes in the API server.
+type SimulatorCloudProvider struct {
+	scenario        *Scenario
+	nodeGroups      []*NodeGroup
+	resourceLimiter *cloudprovider.ResourceLimiter
+	clock           clock.Clock
+
+	// start is the time the simulation started, the events of the scenario
+	// are relative to it.
+	start time.Time
+	// nextEvent is the index of the first event not applied yet.
+	nextEvent int
+	eventLock sync.Mutex
+}
+
+// NewSimulatorCloudProvider creates a simulator for the scenario. Fake nodes
+// of the scenario's node groups already in the cluster are adopted, and node
+// groups with fewer nodes are scaled to their initial size.
+func NewSimulatorCloudProvider(scenario *Scenario, client kubernetes.Interface, clock clock.Clock, rl *cloudprovider.ResourceLimiter) (*SimulatorCloudProvider, error) {
+	provider := &SimulatorCloudProvider{
+		scenario:        scenario,
+		resourceLimiter: rl,
+		clock:           clock,
+		start:           clock.Now(),
+	}
+	nodes, err := client.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{LabelSelector: nodeGroupLabel})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fake nodes: %v", err)
+	}
+	for _, spec := range scenario.NodeGroups {
+		nodeGroup := newNodeGroup(spec, scenario.instanceType(spec.InstanceType), client, clock)
+		for i := range nodes.Items {
+			if nodes.Items[i].Labels[nodeGroupLabel] == spec.Name {
+				nodeGroup.adopt(&nodes.Items[i])
+			}
+		}
+		if size, _ := nodeGroup.TargetSize(); size < spec.InitialSize {
+			if err := nodeGroup.IncreaseSize(spec.InitialSize - size); err != nil {
+				return nil, err
+			}
+		}
+		provider.nodeGroups = append(provider.nodeGroups, nodeGroup)
+	}
+	return provider, nil
+}
+
+// Name returns name of the cloud provider.
+func (s *SimulatorCloudProvider) Name() string {
+	return ProviderName
+}
+
+// NodeGroups returns all node groups of the scenario.
+func (s *SimulatorCloudProvider) NodeGroups() []cloudprovider.NodeGroup {
+	result := make([]cloudprovider.NodeGroup, 0, len(s.nodeGroups))
+	for _, nodeGroup := range s.nodeGroups {
+		result = append(result, nodeGroup)
+	}
+	return result
+}
+
+// NodeGroupForNode returns the node group for the given node, nil if the node
+// isn't a fake node of the simulator.
+func (s *SimulatorCloudProvider) NodeGroupForNode(node *apiv1.Node) (cloudprovider.NodeGroup, error) {
+	if !strings.HasPrefix(node.Spec.ProviderID, ProviderName+"://") {
+		return nil, nil
+	}
+	for _, nodeGroup := range s.nodeGroups {
+		if nodeGroup.Id() == node.Labels[nodeGroupLabel] {
+			return nodeGroup, nil
+		}
+	}
+	return nil, nil
+}
+
+// HasInstance returns whether the node has a corresponding instance in the simulator.
+func (s *SimulatorCloudProvider) HasInstance(node *apiv1.Node) (bool, error) {
+	nodeGroup, err := s.NodeGroupForNode(node)
+	if err != nil || nodeGroup == nil {
+		return false, err
+	}
+	ng := nodeGroup.(*NodeGroup)
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+	return ng.findInstance(node.Spec.ProviderID) >= 0, nil
+}
+
+// Pricing returns pricing model for this cloud provider or error if not available.
+func (s *SimulatorCloudProvider) Pricing() (cloudprovider.PricingModel, caerrors.AutoscalerError) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+// GetAvailableMachineTypes returns the instance types of the scenario.
+func (s *SimulatorCloudProvider) GetAvailableMachineTypes() ([]string, error) {
+	result := make([]string, 0, len(s.scenario.InstanceTypes))
+	for _, instanceType := range s.scenario.InstanceTypes {
+		result = append(result, instanceType.Name)
+	}
+	return result, nil
+}
+
+// NewNodeGroup builds a theoretical node group based on the node definition provided.
+func (s *SimulatorCloudProvider) NewNodeGroup(machineType string, labels map[string]string, systemLabels map[string]string,
+	taints []apiv1.Taint, extraResources map[string]resource.Quantity) (cloudprovider.NodeGroup, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+// GetResourceLimiter returns struct containing limits (max, min) for resources (cores, memory etc.).
+func (s *SimulatorCloudProvider) GetResourceLimiter() (*cloudprovider.ResourceLimiter, error) {
+	return s.resourceLimiter, nil
+}
+
+// GPULabel returns the label added to nodes with GPU resource.
+func (s *SimulatorCloudProvider) GPULabel() string {
+	return GPULabel
+}
+
+// GetAvailableGPUTypes return all available GPU types cloud provider supports.
+func (s *SimulatorCloudProvider) GetAvailableGPUTypes() map[string]struct{} {
+	return s.scenario.gpuTypes()
+}
+
+// GetNodeGpuConfig returns the label, type and resource name for the GPU added to node. If node doesn't have
+// any GPUs, it returns nil.
+func (s *SimulatorCloudProvider) GetNodeGpuConfig(node *apiv1.Node) *cloudprovider.GpuConfig {
+	return gpu.GetNodeGPUFromCloudProvider(s, node)
+}
+
+// Cleanup cleans up all resources before the cloud provider is removed.
+func (s *SimulatorCloudProvider) Cleanup() error {
+	return nil
+}
+
+// Refresh applies the events of the scenario that are due and creates the
+// fake nodes of instances that finished provisioning.
+func (s *SimulatorCloudProvider) Refresh() error {
+	ctx := context.TODO()
+	if err := s.applyDueEvents(ctx); err != nil {
+		return err
+	}
+	var errs []error
+	for _, nodeGroup := range s.nodeGroups {
+		errs = append(errs, nodeGroup.provision(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+func (s *SimulatorCloudProvider) applyDueEvents(ctx context.Context) error {
+	s.eventLock.Lock()
+	defer s.eventLock.Unlock()
+
+	elapsed := s.clock.Since(s.start)
+	for ; s.nextEvent < len(s.scenario.Events); s.nextEvent++ {
+		event := s.scenario.Events[s.nextEvent]
+		if event.After.Duration > elapsed {
+			break
+		}
+		klog.V(1).Infof("Applying scenario event %d to node group %s", s.nextEvent, event.NodeGroup)
+		for _, nodeGroup := range s.nodeGroups {
+			if nodeGroup.Id() != event.NodeGroup {
+				continue
+			}
+			if err := nodeGroup.applyEvent(ctx, event); err != nil {
+				return fmt.Errorf("failed to apply scenario event %d: %v", s.nextEvent, err)
+			}
+		}
+	}
+	return nil
+}
+
+// BuildSimulator builds the simulator cloud provider from the scenario file
+// passed as cloud config.
+func BuildSimulator(opts *coreoptions.AutoscalerOptions, do cloudprovider.NodeGroupDiscoveryOptions, rl *cloudprovider.ResourceLimiter) cloudprovider.CloudProvider {
+	if opts.CloudConfig == "" {
+		klog.Fatalf("The simulator cloud provider requires a scenario file passed with --cloud-config")
+	}
+	scenario, err := LoadScenario(opts.CloudConfig)
+	if err != nil {
+		klog.Fatalf("Failed to load scenario: %v", err)
+	}
+	client := kubernetes.NewForConfigOrDie(kube_util.GetKubeConfig(opts.KubeClientOpts))
+	provider, err := NewSimulatorCloudProvider(scenario, client, clock.RealClock{}, rl)
+	if err != nil {
+		klog.Fatalf("Failed to create simulator cloud provider: %v", err)
+	}
+	return provider
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+)
+
+func newTestSimulator(t *testing.T, objects ...*apiv1.Node) (*SimulatorCloudProvider, *fake.Clientset, *testingclock.FakeClock) {
+	scenario, err := parseScenario([]byte(testScenario))
+	assert.NoError(t, err)
+	client := fake.NewSimpleClientset()
+	for _, node := range objects {
+		_, err := client.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+	clock := testingclock.NewFakeClock(time.Now())
+	provider, err := NewSimulatorCloudProvider(scenario, client, clock, nil)
+	assert.NoError(t, err)
+	return provider, client, clock
+}
+
+func listNodes(t *testing.T, client *fake.Clientset) []apiv1.Node {
+	nodes, err := client.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	return nodes.Items
+}
+
+func instanceStates(t *testing.T, nodeGroup cloudprovider.NodeGroup) []cloudprovider.InstanceState {
+	instances, err := nodeGroup.Nodes()
+	assert.NoError(t, err)
+	var states []cloudprovider.InstanceState
+	for _, instance := range instances {
+		states = append(states, instance.Status.State)
+	}
+	return states
+}
+
+func TestProvisioningDelay(t *testing.T) {
+	provider, client, clock := newTestSimulator(t)
+	general := provider.NodeGroups()[0]
+
+	// The initial node is created once its provisioning delay has passed.
+	assert.NoError(t, provider.Refresh())
+	assert.Empty(t, listNodes(t, client))
+	assert.Equal(t, []cloudprovider.InstanceState{cloudprovider.InstanceCreating}, instanceStates(t, general))
+
+	clock.Step(time.Minute)
+	assert.NoError(t, provider.Refresh())
+	nodes := listNodes(t, client)
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, "small", nodes[0].Labels[apiv1.LabelInstanceType])
+	assert.Equal(t, "general", nodes[0].Labels[nodeGroupLabel])
+	assert.Equal(t, "fake", nodes[0].Annotations[kwokNodeAnnotation])
+	assert.Equal(t, []cloudprovider.InstanceState{cloudprovider.InstanceRunning}, instanceStates(t, general))
+
+	nodeGroup, err := provider.NodeGroupForNode(&nodes[0])
+	assert.NoError(t, err)
+	assert.Equal(t, general, nodeGroup)
+	hasInstance, err := provider.HasInstance(&nodes[0])
+	assert.NoError(t, err)
+	assert.True(t, hasInstance)
+
+	// Instances still being created can be cancelled.
+	assert.NoError(t, general.IncreaseSize(2))
+	assert.Error(t, general.DecreaseTargetSize(-3))
+	assert.NoError(t, general.DecreaseTargetSize(-1))
+	size, err := general.TargetSize()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, size)
+
+	// Deleting a node deletes its fake node.
+	assert.Error(t, general.DeleteNodes([]*apiv1.Node{&nodes[0], &nodes[0]}))
+	clock.Step(time.Minute)
+	assert.NoError(t, provider.Refresh())
+	assert.Len(t, listNodes(t, client), 2)
+	assert.NoError(t, general.DeleteNodes([]*apiv1.Node{&nodes[0]}))
+	assert.Len(t, listNodes(t, client), 1)
+}
+
+func TestScenarioEvents(t *testing.T) {
+	provider, client, clock := newTestSimulator(t)
+	general := provider.NodeGroups()[0]
+	accelerated := provider.NodeGroups()[1]
+
+	// Stockout from 5m to 10m.
+	clock.Step(5 * time.Minute)
+	assert.NoError(t, provider.Refresh())
+	assert.NoError(t, general.IncreaseSize(1))
+	instances, err := general.Nodes()
+	assert.NoError(t, err)
+	assert.Len(t, instances, 2)
+	assert.Equal(t, &cloudprovider.InstanceErrorInfo{
+		ErrorClass:   cloudprovider.OutOfResourcesErrorClass,
+		ErrorCode:    stockoutErrorCode,
+		ErrorMessage: "instance type small is out of stock",
+	}, instances[1].Status.ErrorInfo)
+
+	// Failed instances never become nodes, and are deleted like nodes.
+	clock.Step(5 * time.Minute)
+	assert.NoError(t, provider.Refresh())
+	assert.Len(t, listNodes(t, client), 1)
+	assert.NoError(t, general.DeleteNodes([]*apiv1.Node{{Spec: apiv1.NodeSpec{ProviderID: instances[1].Id}}}))
+	assert.NoError(t, general.IncreaseSize(1))
+	instances, err = general.Nodes()
+	assert.NoError(t, err)
+	assert.Nil(t, instances[1].Status.ErrorInfo)
+
+	// Errors from 20m on.
+	clock.Step(10 * time.Minute)
+	assert.NoError(t, provider.Refresh())
+	assert.NoError(t, accelerated.IncreaseSize(1))
+	instances, err = accelerated.Nodes()
+	assert.NoError(t, err)
+	assert.Equal(t, &cloudprovider.InstanceErrorInfo{
+		ErrorClass:   cloudprovider.OtherErrorClass,
+		ErrorCode:    "QUOTA_EXCEEDED",
+		ErrorMessage: "GPU quota exceeded",
+	}, instances[0].Status.ErrorInfo)
+}
+
+func TestPreempt(t *testing.T) {
+	provider, client, clock := newTestSimulator(t)
+	general := provider.NodeGroups()[0].(*NodeGroup)
+	clock.Step(time.Minute)
+	assert.NoError(t, provider.Refresh())
+	assert.Len(t, listNodes(t, client), 1)
+
+	assert.NoError(t, general.applyEvent(context.TODO(), Event{Preempt: 2}))
+	assert.Empty(t, listNodes(t, client))
+	size, err := general.TargetSize()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, size)
+}
+
+func TestAdoptExistingNodes(t *testing.T) {
+	scenario, err := parseScenario([]byte(testScenario))
+	assert.NoError(t, err)
+	general := newNodeGroup(scenario.NodeGroups[0], scenario.instanceType("small"), nil, testingclock.NewFakeClock(time.Now()))
+	existing := general.buildNode("general-existing")
+
+	provider, client, _ := newTestSimulator(t, existing)
+	assert.Len(t, listNodes(t, client), 1)
+	// The existing node counts towards the initial size.
+	assert.Equal(t, []cloudprovider.InstanceState{cloudprovider.InstanceRunning}, instanceStates(t, provider.NodeGroups()[0]))
+	hasInstance, err := provider.HasInstance(existing)
+	assert.NoError(t, err)
+	assert.True(t, hasInstance)
+}
+
+func TestTemplateNodeInfo(t *testing.T) {
+	provider, _, _ := newTestSimulator(t)
+	nodeInfo, err := provider.NodeGroups()[1].TemplateNodeInfo()
+	assert.NoError(t, err)
+	node := nodeInfo.Node()
+	assert.Equal(t, "ml", node.Labels["team"])
+	assert.Equal(t, "t4", node.Labels[GPULabel])
+	assert.Equal(t, int64(8), node.Status.Allocatable.Cpu().Value())
+	assert.Len(t, node.Spec.Taints, 1)
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/cluster-autoscaler/pkg/cloudprovider"
+	"sigs.k8s.io/cluster-autoscaler/pkg/config"
+	"sigs.k8s.io/cluster-autoscaler/pkg/simulator/framework"
+	"sigs.k8s.io/cluster-autoscaler/pkg/utils/gpu"
+)
+
+const (
+	// nodeGroupLabel holds the node group of fake nodes.
+	nodeGroupLabel = "simulator.cluster-autoscaler.k8s.io/node-group"
+	// kwokNodeAnnotation hands fake nodes to a kwok controller, if one runs in
+	// the cluster, which keeps them ready and runs their pods.
+	kwokNodeAnnotation = "kwok.x-k8s.io/node"
+
+	stockoutErrorCode = "STOCKOUT"
+	defaultPods       = "110"
+)
+
+// instance is a simulated cloud instance. Instances become nodes once their
+// provisioning delay has passed, unless they failed.
+type instance struct {
+	name      string
+	state     cloudprovider.InstanceState
+	readyAt   time.Time
+	errorInfo *cloudprovider.InstanceErrorInfo
+}
+
+func (i *instance) providerID() string {
+	return ProviderName + "://" + i.name
+}
+
+// NodeGroup implements cloudprovider.NodeGroup for a node group of a scenario.
+type NodeGroup struct {
+	spec         NodeGroupSpec
+	instanceType *InstanceType
+	client       kubernetes.Interface
+	clock        clock.Clock
+
+	mutex             sync.Mutex
+	provisioningDelay time.Duration
+	stockout          bool
+	instanceError     *InstanceError
+	instances         []*instance
+}
+
+func newNodeGroup(spec NodeGroupSpec, instanceType *InstanceType, client kubernetes.Interface, clock clock.Clock) *NodeGroup {
+	return &NodeGroup{
+		spec:              spec,
+		instanceType:      instanceType,
+		client:            client,
+		clock:             clock,
+		provisioningDelay: spec.ProvisioningDelay.Duration,
+	}
+}
+
+// MaxSize returns maximum size of the node group.
+func (ng *NodeGroup) MaxSize() int {
+	return ng.spec.MaxSize
+}
+
+// MinSize returns minimum size of the node group.
+func (ng *NodeGroup) MinSize() int {
+	return ng.spec.MinSize
+}
+
+// TargetSize returns the current target size of the node group.
+func (ng *NodeGroup) TargetSize() (int, error) {
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+	return len(ng.instances), nil
+}
+
+// IncreaseSize adds delta instances to the node group. Depending on the
+// current events of the scenario they fail right away.
+func (ng *NodeGroup) IncreaseSize(delta int) error {
+	if delta <= 0 {
+		return fmt.Errorf("size increase must be positive")
+	}
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+
+	if size := len(ng.instances) + delta; size > ng.spec.MaxSize {
+		return fmt.Errorf("size increase too large - desired:%d max:%d", size, ng.spec.MaxSize)
+	}
+	for i := 0; i < delta; i++ {
+		ng.instances = append(ng.instances, ng.newInstance())
+	}
+	return nil
+}
+
+func (ng *NodeGroup) newInstance() *instance {
+	inst := &instance{
+		name:    fmt.Sprintf("%s-%s", ng.spec.Name, rand.String(8)),
+		state:   cloudprovider.InstanceCreating,
+		readyAt: ng.clock.Now().Add(ng.provisioningDelay),
+	}
+	switch {
+	case ng.stockout:
+		inst.errorInfo = &cloudprovider.InstanceErrorInfo{
+			ErrorClass:   cloudprovider.OutOfResourcesErrorClass,
+			ErrorCode:    stockoutErrorCode,
+			ErrorMessage: fmt.Sprintf("instance type %s is out of stock", ng.instanceType.Name),
+		}
+	case ng.instanceError != nil:
+		errorClass := cloudprovider.OtherErrorClass
+		if ng.instanceError.Class == outOfResourcesErrorClass {
+			errorClass = cloudprovider.OutOfResourcesErrorClass
+		}
+		inst.errorInfo = &cloudprovider.InstanceErrorInfo{
+			ErrorClass:   errorClass,
+			ErrorCode:    ng.instanceError.Code,
+			ErrorMessage: ng.instanceError.Message,
+		}
+	}
+	return inst
+}
+
+// AtomicIncreaseSize is not implemented.
+func (ng *NodeGroup) AtomicIncreaseSize(delta int) error {
+	return cloudprovider.ErrNotImplemented
+}
+
+// DeleteNodes deletes the instances of the given nodes and their fake nodes.
+func (ng *NodeGroup) DeleteNodes(nodes []*apiv1.Node) error {
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+
+	if len(ng.instances)-len(nodes) < ng.spec.MinSize {
+		return fmt.Errorf("deleting %d nodes would take node group %s below its minimum size %d", len(nodes), ng.spec.Name, ng.spec.MinSize)
+	}
+	for _, node := range nodes {
+		i := ng.findInstance(node.Spec.ProviderID)
+		if i < 0 {
+			return fmt.Errorf("node %s doesn't belong to node group %s", node.Name, ng.spec.Name)
+		}
+		if err := ng.deleteInstance(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForceDeleteNodes deletes nodes from the group regardless of constraints.
+func (ng *NodeGroup) ForceDeleteNodes(nodes []*apiv1.Node) error {
+	return cloudprovider.ErrNotImplemented
+}
+
+// DecreaseTargetSize removes instances that are still being created.
+func (ng *NodeGroup) DecreaseTargetSize(delta int) error {
+	if delta >= 0 {
+		return fmt.Errorf("size decrease must be negative")
+	}
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+
+	creating := 0
+	for _, inst := range ng.instances {
+		if inst.state == cloudprovider.InstanceCreating {
+			creating++
+		}
+	}
+	if creating < -delta {
+		return fmt.Errorf("attempt to delete existing nodes, creating: %d delta: %d", creating, delta)
+	}
+	for i := len(ng.instances) - 1; i >= 0 && delta < 0; i-- {
+		if ng.instances[i].state == cloudprovider.InstanceCreating {
+			ng.instances = append(ng.instances[:i], ng.instances[i+1:]...)
+			delta++
+		}
+	}
+	return nil
+}
+
+// Id returns the node group name.
+func (ng *NodeGroup) Id() string {
+	return ng.spec.Name
+}
+
+// Debug returns a string containing all information regarding this node group.
+func (ng *NodeGroup) Debug() string {
+	size, _ := ng.TargetSize()
+	return fmt.Sprintf("%s (%s, %d:%d, target %d)", ng.spec.Name, ng.instanceType.Name, ng.spec.MinSize, ng.spec.MaxSize, size)
+}
+
+// Nodes returns the instances of the node group.
+func (ng *NodeGroup) Nodes() ([]cloudprovider.Instance, error) {
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+
+	instances := make([]cloudprovider.Instance, 0, len(ng.instances))
+	for _, inst := range ng.instances {
+		instances = append(instances, cloudprovider.Instance{
+			Id: inst.providerID(),
+			Status: &cloudprovider.InstanceStatus{
+				State:     inst.state,
+				ErrorInfo: inst.errorInfo,
+			},
+		})
+	}
+	return instances, nil
+}
+
+// TemplateNodeInfo returns a node template for this node group.
+func (ng *NodeGroup) TemplateNodeInfo() (*framework.NodeInfo, error) {
+	node := ng.buildNode(fmt.Sprintf("%s-template-%s", ng.spec.Name, rand.String(8)))
+	return framework.NewNodeInfo(node, nil, framework.NewPodInfo(cloudprovider.BuildKubeProxy(ng.spec.Name), nil)), nil
+}
+
+// Exist checks if the node group really exists on the cloud provider side.
+func (ng *NodeGroup) Exist() bool {
+	return true
+}
+
+// Create creates the node group on the cloud provider side.
+func (ng *NodeGroup) Create() (cloudprovider.NodeGroup, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+// Delete deletes the node group on the cloud provider side.
+func (ng *NodeGroup) Delete() error {
+	return cloudprovider.ErrNotImplemented
+}
+
+// Autoprovisioned returns true if the node group is autoprovisioned.
+func (ng *NodeGroup) Autoprovisioned() bool {
+	return false
+}
+
+// GetOptions returns NodeGroupAutoscalingOptions that should be used for this particular
+// NodeGroup. Returning a nil will result in using default options.
+func (ng *NodeGroup) GetOptions(defaults config.NodeGroupAutoscalingOptions) (*config.NodeGroupAutoscalingOptions, error) {
+	return nil, cloudprovider.ErrNotImplemented
+}
+
+// applyEvent changes the behaviour of the node group as described by event.
+func (ng *NodeGroup) applyEvent(ctx context.Context, event Event) error {
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+
+	if event.Stockout != nil {
+		ng.stockout = *event.Stockout
+	}
+	if event.ProvisioningDelay != nil {
+		ng.provisioningDelay = event.ProvisioningDelay.Duration
+	}
+	if event.Error != nil {
+		ng.instanceError = event.Error
+	}
+	if event.ClearError {
+		ng.instanceError = nil
+	}
+	for i := len(ng.instances) - 1; i >= 0 && event.Preempt > 0; i-- {
+		if ng.instances[i].state != cloudprovider.InstanceRunning {
+			continue
+		}
+		klog.V(2).Infof("Preempting instance %s of node group %s", ng.instances[i].name, ng.spec.Name)
+		if err := ng.deleteInstance(i); err != nil {
+			return err
+		}
+		event.Preempt--
+	}
+	return nil
+}
+
+// provision creates the fake nodes of instances whose provisioning delay has
+// passed. Failed instances are kept until they are deleted.
+func (ng *NodeGroup) provision(ctx context.Context) error {
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+
+	now := ng.clock.Now()
+	for _, inst := range ng.instances {
+		if inst.state != cloudprovider.InstanceCreating || inst.errorInfo != nil || now.Before(inst.readyAt) {
+			continue
+		}
+		_, err := ng.client.CoreV1().Nodes().Create(ctx, ng.buildNode(inst.name), metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create node %s: %v", inst.name, err)
+		}
+		klog.V(2).Infof("Created node %s of node group %s", inst.name, ng.spec.Name)
+		inst.state = cloudprovider.InstanceRunning
+	}
+	return nil
+}
+
+// adopt adds a running instance for an existing fake node, e.g. one created
+// before a restart of the autoscaler.
+func (ng *NodeGroup) adopt(node *apiv1.Node) {
+	ng.mutex.Lock()
+	defer ng.mutex.Unlock()
+	ng.instances = append(ng.instances, &instance{name: node.Name, state: cloudprovider.InstanceRunning})
+}
+
+func (ng *NodeGroup) findInstance(providerID string) int {
+	for i, inst := range ng.instances {
+		if inst.providerID() == providerID {
+			return i
+		}
+	}
+	return -1
+}
+
+// deleteInstance deletes the instance at index i and its node. Must be called
+// with the mutex held.
+func (ng *NodeGroup) deleteInstance(i int) error {
+	inst := ng.instances[i]
+	if inst.state == cloudprovider.InstanceRunning {
+		err := ng.client.CoreV1().Nodes().Delete(context.TODO(), inst.name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete node %s: %v", inst.name, err)
+		}
+		klog.V(2).Infof("Deleted node %s of node group %s", inst.name, ng.spec.Name)
+	}
+	ng.instances = append(ng.instances[:i], ng.instances[i+1:]...)
+	return nil
+}
+
+// buildNode returns a fake node of the node group's instance type.
+func (ng *NodeGroup) buildNode(name string) *apiv1.Node {
+	it := ng.instanceType
+	pods := resource.MustParse(defaultPods)
+	if it.Pods != nil {
+		pods = *it.Pods
+	}
+	node := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				apiv1.LabelHostname:     name,
+				apiv1.LabelOSStable:     "linux",
+				apiv1.LabelArchStable:   "amd64",
+				apiv1.LabelInstanceType: it.Name,
+				nodeGroupLabel:          ng.spec.Name,
+			},
+			Annotations: map[string]string{
+				kwokNodeAnnotation: "fake",
+			},
+		},
+		Spec: apiv1.NodeSpec{
+			ProviderID: ProviderName + "://" + name,
+			Taints:     append([]apiv1.Taint(nil), it.Taints...),
+		},
+		Status: apiv1.NodeStatus{
+			Capacity: apiv1.ResourceList{
+				apiv1.ResourceCPU:    it.CPU,
+				apiv1.ResourceMemory: it.Memory,
+				apiv1.ResourcePods:   pods,
+			},
+			Conditions: []apiv1.NodeCondition{
+				{
+					Type:               apiv1.NodeReady,
+					Status:             apiv1.ConditionTrue,
+					Reason:             "KubeletReady",
+					LastHeartbeatTime:  metav1.NewTime(ng.clock.Now()),
+					LastTransitionTime: metav1.NewTime(ng.clock.Now()),
+				},
+			},
+		},
+	}
+	if it.GPU > 0 {
+		node.Status.Capacity[gpu.ResourceNvidiaGPU] = *resource.NewQuantity(it.GPU, resource.DecimalSI)
+		node.Labels[GPULabel] = it.gpuType()
+	}
+	for key, value := range it.Labels {
+		node.Labels[key] = value
+	}
+	for key, value := range ng.spec.Labels {
+		node.Labels[key] = value
+	}
+	node.Status.Allocatable = node.Status.Capacity
+	return node
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Scenario describes a simulated cloud: its instance types, node groups and a
+// timeline of events changing the behaviour of the node groups.
+type Scenario struct {
+	InstanceTypes []InstanceType  `json:"instanceTypes"`
+	NodeGroups    []NodeGroupSpec `json:"nodeGroups"`
+	// Events are applied in order of their After field.
+	Events []Event `json:"events,omitempty"`
+}
+
+// InstanceType describes the nodes created for an instance type.
+type InstanceType struct {
+	Name   string            `json:"name"`
+	CPU    resource.Quantity `json:"cpu"`
+	Memory resource.Quantity `json:"memory"`
+	// Pods defaults to 110.
+	Pods *resource.Quantity `json:"pods,omitempty"`
+	GPU  int64              `json:"gpu,omitempty"`
+	// GPUType is the value of the GPU label of nodes with GPUs, defaults
+	// to "simulated".
+	GPUType string            `json:"gpuType,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Taints  []apiv1.Taint     `json:"taints,omitempty"`
+}
+
+// NodeGroupSpec describes a node group.
+type NodeGroupSpec struct {
+	Name         string `json:"name"`
+	InstanceType string `json:"instanceType"`
+	MinSize      int    `json:"minSize"`
+	MaxSize      int    `json:"maxSize"`
+	// InitialSize is the number of nodes created when the simulation starts.
+	InitialSize int `json:"initialSize,omitempty"`
+	// ProvisioningDelay is the time between a scale-up and the creation of
+	// the node.
+	ProvisioningDelay metav1.Duration `json:"provisioningDelay,omitempty"`
+	// Labels are added to the labels of the instance type.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Event changes the behaviour of a node group once After has passed since
+// the start of the simulation. Fields that aren't set are left unchanged.
+type Event struct {
+	After     metav1.Duration `json:"after"`
+	NodeGroup string          `json:"nodeGroup"`
+	// Stockout makes new instances of the node group fail with an out of
+	// resources error while true.
+	Stockout *bool `json:"stockout,omitempty"`
+	// ProvisioningDelay replaces the provisioning delay of the node group.
+	ProvisioningDelay *metav1.Duration `json:"provisioningDelay,omitempty"`
+	// Error makes new instances of the node group fail with the given error,
+	// until an event clears it with ClearError.
+	Error      *InstanceError `json:"error,omitempty"`
+	ClearError bool           `json:"clearError,omitempty"`
+	// Preempt deletes the given number of running instances of the node group.
+	Preempt int `json:"preempt,omitempty"`
+}
+
+// InstanceError is an error reported for instances that failed to be created.
+type InstanceError struct {
+	// Class is either OutOfResources or Other.
+	Class   string `json:"class"`
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	outOfResourcesErrorClass = "OutOfResources"
+	otherErrorClass          = "Other"
+
+	defaultGPUType = "simulated"
+)
+
+// LoadScenario reads and validates the scenario in the given YAML file.
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario %s: %v", path, err)
+	}
+	scenario, err := parseScenario(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %v", path, err)
+	}
+	return scenario, nil
+}
+
+func parseScenario(data []byte) (*Scenario, error) {
+	scenario := &Scenario{}
+	if err := yaml.UnmarshalStrict(data, scenario); err != nil {
+		return nil, err
+	}
+	if err := scenario.validate(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(scenario.Events, func(i, j int) bool {
+		return scenario.Events[i].After.Duration < scenario.Events[j].After.Duration
+	})
+	return scenario, nil
+}
+
+func (s *Scenario) validate() error {
+	instanceTypes := make(map[string]bool, len(s.InstanceTypes))
+	for _, instanceType := range s.InstanceTypes {
+		if instanceType.Name == "" {
+			return fmt.Errorf("instance type without a name")
+		}
+		if instanceTypes[instanceType.Name] {
+			return fmt.Errorf("duplicate instance type %s", instanceType.Name)
+		}
+		if instanceType.CPU.IsZero() || instanceType.Memory.IsZero() {
+			return fmt.Errorf("instance type %s must have cpu and memory", instanceType.Name)
+		}
+		if instanceType.GPU < 0 {
+			return fmt.Errorf("instance type %s has a negative number of gpus", instanceType.Name)
+		}
+		instanceTypes[instanceType.Name] = true
+	}
+
+	if len(s.NodeGroups) == 0 {
+		return fmt.Errorf("no node groups")
+	}
+	nodeGroups := make(map[string]bool, len(s.NodeGroups))
+	for _, nodeGroup := range s.NodeGroups {
+		if nodeGroup.Name == "" {
+			return fmt.Errorf("node group without a name")
+		}
+		if nodeGroups[nodeGroup.Name] {
+			return fmt.Errorf("duplicate node group %s", nodeGroup.Name)
+		}
+		if !instanceTypes[nodeGroup.InstanceType] {
+			return fmt.Errorf("node group %s has unknown instance type %q", nodeGroup.Name, nodeGroup.InstanceType)
+		}
+		if nodeGroup.MinSize < 0 || nodeGroup.MaxSize < nodeGroup.MinSize {
+			return fmt.Errorf("node group %s has invalid sizes %d:%d", nodeGroup.Name, nodeGroup.MinSize, nodeGroup.MaxSize)
+		}
+		if nodeGroup.InitialSize < nodeGroup.MinSize || nodeGroup.InitialSize > nodeGroup.MaxSize {
+			return fmt.Errorf("node group %s has initial size %d outside of %d:%d", nodeGroup.Name, nodeGroup.InitialSize, nodeGroup.MinSize, nodeGroup.MaxSize)
+		}
+		nodeGroups[nodeGroup.Name] = true
+	}
+
+	for i, event := range s.Events {
+		if !nodeGroups[event.NodeGroup] {
+			return fmt.Errorf("event %d refers to unknown node group %q", i, event.NodeGroup)
+		}
+		if event.Error != nil && event.ClearError {
+			return fmt.Errorf("event %d both sets and clears the error", i)
+		}
+		if event.Error != nil && event.Error.Class != outOfResourcesErrorClass && event.Error.Class != otherErrorClass {
+			return fmt.Errorf("event %d has error class %q, must be %s or %s", i, event.Error.Class, outOfResourcesErrorClass, otherErrorClass)
+		}
+		if event.Preempt < 0 {
+			return fmt.Errorf("event %d preempts a negative number of instances", i)
+		}
+	}
+	return nil
+}
+
+// gpuTypes returns the GPU types of all instance types with GPUs.
+func (s *Scenario) gpuTypes() map[string]struct{} {
+	result := map[string]struct{}{}
+	for _, instanceType := range s.InstanceTypes {
+		if instanceType.GPU > 0 {
+			result[instanceType.gpuType()] = struct{}{}
+		}
+	}
+	return result
+}
+
+func (it *InstanceType) gpuType() string {
+	if it.GPUType == "" {
+		return defaultGPUType
+	}
+	return it.GPUType
+}
+
+// instanceType returns the instance type with the given name.
+func (s *Scenario) instanceType(name string) *InstanceType {
+	for i := range s.InstanceTypes {
+		if s.InstanceTypes[i].Name == name {
+			return &s.InstanceTypes[i]
+		}
+	}
+	return nil
+}
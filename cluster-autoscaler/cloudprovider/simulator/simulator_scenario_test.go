@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const testScenario = `
+instanceTypes:
+- name: small
+  cpu: "2"
+  memory: 8Gi
+- name: gpu
+  cpu: "8"
+  memory: 32Gi
+  gpu: 1
+  gpuType: t4
+  taints:
+  - key: nvidia.com/gpu
+    effect: NoSchedule
+nodeGroups:
+- name: general
+  instanceType: small
+  minSize: 1
+  maxSize: 5
+  initialSize: 1
+  provisioningDelay: 1m
+- name: accelerated
+  instanceType: gpu
+  maxSize: 3
+  labels:
+    team: ml
+events:
+- after: 10m
+  nodeGroup: general
+  stockout: false
+- after: 5m
+  nodeGroup: general
+  stockout: true
+- after: 20m
+  nodeGroup: accelerated
+  error:
+    class: Other
+    code: QUOTA_EXCEEDED
+    message: GPU quota exceeded
+`
+
+func TestParseScenario(t *testing.T) {
+	scenario, err := parseScenario([]byte(testScenario))
+	assert.NoError(t, err)
+
+	assert.Len(t, scenario.InstanceTypes, 2)
+	gpuType := scenario.instanceType("gpu")
+	assert.NotNil(t, gpuType)
+	assert.Equal(t, resource.MustParse("32Gi"), gpuType.Memory)
+	assert.Equal(t, []apiv1.Taint{{Key: "nvidia.com/gpu", Effect: apiv1.TaintEffectNoSchedule}}, gpuType.Taints)
+	assert.Equal(t, map[string]struct{}{"t4": {}}, scenario.gpuTypes())
+
+	assert.Len(t, scenario.NodeGroups, 2)
+	assert.Equal(t, time.Minute, scenario.NodeGroups[0].ProvisioningDelay.Duration)
+	assert.Equal(t, map[string]string{"team": "ml"}, scenario.NodeGroups[1].Labels)
+
+	// Events are sorted by time.
+	assert.Len(t, scenario.Events, 3)
+	assert.Equal(t, 5*time.Minute, scenario.Events[0].After.Duration)
+	assert.True(t, *scenario.Events[0].Stockout)
+	assert.Equal(t, 10*time.Minute, scenario.Events[1].After.Duration)
+	assert.Equal(t, &InstanceError{Class: "Other", Code: "QUOTA_EXCEEDED", Message: "GPU quota exceeded"}, scenario.Events[2].Error)
+}
+
+func TestParseScenarioInvalid(t *testing.T) {
+	const instanceTypes = `
+instanceTypes:
+- name: small
+  cpu: "2"
+  memory: 8Gi
+`
+	for name, scenario := range map[string]string{
+		"unknown field": instanceTypes + `
+nodeGroups:
+- name: ng
+  instanceType: small
+  maxSize: 1
+  unknown: true
+`,
+		"no node groups": instanceTypes,
+		"unknown instance type": instanceTypes + `
+nodeGroups:
+- name: ng
+  instanceType: large
+  maxSize: 1
+`,
+		"instance type without memory": `
+instanceTypes:
+- name: small
+  cpu: "2"
+nodeGroups:
+- name: ng
+  instanceType: small
+  maxSize: 1
+`,
+		"max size below min size": instanceTypes + `
+nodeGroups:
+- name: ng
+  instanceType: small
+  minSize: 2
+  maxSize: 1
+`,
+		"initial size above max size": instanceTypes + `
+nodeGroups:
+- name: ng
+  instanceType: small
+  maxSize: 1
+  initialSize: 2
+`,
+		"event of unknown node group": instanceTypes + `
+nodeGroups:
+- name: ng
+  instanceType: small
+  maxSize: 1
+events:
+- after: 1m
+  nodeGroup: other
+  stockout: true
+`,
+		"unknown error class": instanceTypes + `
+nodeGroups:
+- name: ng
+  instanceType: small
+  maxSize: 1
+events:
+- after: 1m
+  nodeGroup: ng
+  error:
+    class: Transient
+    code: FAILED
+`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseScenario([]byte(scenario))
+			assert.Error(t, err)
+		})
+	}
+}
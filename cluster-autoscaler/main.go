@@ -19,10 +19,8 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"net/http"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/spf13/pflag"
@@ -34,7 +32,11 @@ import (
 	"k8s.io/apiserver/pkg/server/mux"
 	"k8s.io/apiserver/pkg/server/routes"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	cbv1alpha1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1alpha1"
+	cbv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacitybuffer/autoscaling.x-k8s.io/v1beta1"
 	cqv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacityquota/autoscaling.x-k8s.io/v1beta1"
+	provreqv1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	provreqv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1beta1"
 	"k8s.io/autoscaler/cluster-autoscaler/version"
 	"k8s.io/client-go/informers"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -54,7 +56,6 @@ import (
 	// The router package is used to provide support for custom build tags (e.g. -tags aws).
 	_ "k8s.io/autoscaler/cluster-autoscaler/cloudprovider/router"
 
-	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	kube_flag "k8s.io/component-base/cli/flag"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -65,8 +66,11 @@ import (
 	"k8s.io/component-base/metrics/legacyregistry"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 )
@@ -78,39 +82,39 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(cqv1beta1.AddToScheme(scheme))
-	// TODO: add other CRDs
+	utilruntime.Must(cbv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(cbv1beta1.AddToScheme(scheme))
+	utilruntime.Must(provreqv1beta1.AddToScheme(scheme))
+	utilruntime.Must(provreqv1.AddToScheme(scheme))
 }
 
-func registerSignalHandlers(autoscaler core.Autoscaler) {
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGQUIT)
-	klog.V(1).Info("Registered cleanup signal handler")
-
-	go func() {
-		<-sigs
-		klog.V(1).Info("Received signal, attempting cleanup")
-		autoscaler.ExitCleanUp()
-		klog.V(1).Info("Cleaned up, exiting...")
-		klog.Flush()
-		os.Exit(0)
-	}()
-}
-
-func run(healthCheck *metrics.HealthCheck, debuggingSnapshotter debuggingsnapshot.DebuggingSnapshotter) {
+func run(ctx context.Context, leaderElection componentbaseconfig.LeaderElectionConfiguration, healthCheck *metrics.HealthCheck, debuggingSnapshotter debuggingsnapshot.DebuggingSnapshotter) {
 	autoscalingOpts := flags.AutoscalingOptions()
 
 	metrics.RegisterAll(autoscalingOpts.EmitPerNodeGroupMetrics)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 
 	restConfig := kube_util.GetKubeConfig(autoscalingOpts.KubeClientOpts)
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme: scheme,
 		Cache: cache.Options{
 			DefaultTransform: cache.TransformStripManagedFields(),
+			ByObject: map[client.Object]cache.ByObject{
+				&cqv1beta1.CapacityQuota{}: {Transform: ignoreScopedCapacityQuotas},
+			},
 		},
-		// TODO: migrate leader election, metrics, healthcheck, pprof servers to Manager
-		LeaderElection:         false,
+		LeaderElection:             leaderElection.LeaderElect,
+		LeaderElectionResourceLock: leaderElection.ResourceLock,
+		LeaderElectionNamespace:    autoscalingOpts.ConfigNamespace,
+		LeaderElectionID:           leaderElection.ResourceName,
+		LeaseDuration:              &leaderElection.LeaseDuration.Duration,
+		RenewDeadline:              &leaderElection.RenewDeadline.Duration,
+		RetryPeriod:                &leaderElection.RetryPeriod.Duration,
+		// Step down as soon as the autoscaler stops, so that the next replica
+		// doesn't have to wait for the lease to expire during rolling updates.
+		LeaderElectionReleaseOnCancel: true,
+		GracefulShutdownTimeout:       ptr.To(gracefulShutdownTimeout),
+		// The built-in servers only expose controller-runtime metrics, and would change
+		// the ports of the endpoints. They are served by the server added below instead.
 		Metrics:                metricsserver.Options{BindAddress: "0"},
 		HealthProbeBindAddress: "0",
 		PprofBindAddress:       "0",
@@ -119,21 +123,61 @@ func run(healthCheck *metrics.HealthCheck, debuggingSnapshotter debuggingsnapsho
 		klog.Fatalf("Failed to create manager: %v", err)
 	}
 
-	autoscaler, trigger := mustBuildAutoscaler(ctx, autoscalingOpts, debuggingSnapshotter, mgr)
-
-	// Register signal handlers for graceful shutdown.
-	// TODO: replace with ctrl.SetupSignalHandlers() and handle graceful shutdown with context
-	registerSignalHandlers(autoscaler)
-
-	// Start updating health check endpoint.
-	healthCheck.StartMonitoring()
+	// The types of all autoscaler CRDs are registered in the scheme, so that components
+	// built with the manager can read them through its cache. The capacity buffer
+	// controller and the ProvisioningRequest processors still watch CapacityBuffers and
+	// ProvisioningRequests through their own clientsets and informers, which are set
+	// up by the builder outside this module. Informers started here from the manager
+	// cache would only add watches nothing reads, so they are left to those components
+	// until they read through the manager.
+	missing, err := missingCRDs(mgr.GetRESTMapper(), scheme, crdObjects(autoscalingOpts))
+	if err != nil {
+		klog.Fatalf("Failed to check CustomResourceDefinitions: %v", err)
+	}
+	for _, kind := range missing {
+		klog.Warningf("The CustomResourceDefinition of %s isn't installed", kind)
+	}
 
-	// Start components running in background.
-	if err := autoscaler.Start(); err != nil {
-		klog.Fatalf("Failed to autoscaler background components: %v", err)
+	// The health check reports whether the informers that components started from
+	// the manager cache have synced. It doesn't start informers of its own.
+	cacheHealth := newCacheHealthCheck(mgr.GetCache(), autoscalingOpts.MaxStartupTime)
+
+	// The server runs on all replicas, including the ones waiting for leadership.
+	shutdownTimeout := httpShutdownTimeout
+	err = mgr.Add(&manager.Server{
+		Name: "cluster-autoscaler",
+		Server: &http.Server{
+			Addr:    autoscalingOpts.Address,
+			Handler: newHTTPHandler(autoscalingOpts, healthCheck, cacheHealth, debuggingSnapshotter),
+		},
+		ShutdownTimeout: &shutdownTimeout,
+	})
+	if err != nil {
+		klog.Fatalf("Failed to add server to manager: %v", err)
 	}
 
+	// The autoscaler is built and runs only on the leader, so that standby replicas
+	// don't start its informers and background components.
 	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		autoscaler, trigger := mustBuildAutoscaler(ctx, autoscalingOpts, debuggingSnapshotter, mgr)
+
+		// Iterations run on a context that isn't cancelled on shutdown, so that the
+		// iteration in progress finishes before the autoscaler is cleaned up.
+		iterationCtx := context.WithoutCancel(ctx)
+
+		// Start updating health check endpoint.
+		healthCheck.StartMonitoring()
+
+		// Start components running in background.
+		if err := autoscaler.Start(); err != nil {
+			return fmt.Errorf("failed to start autoscaler background components: %v", err)
+		}
+		defer func() {
+			klog.V(1).Info("Autoscaler stopped, attempting cleanup")
+			autoscaler.ExitCleanUp()
+			klog.V(1).Info("Cleaned up")
+		}()
+
 		// Autoscale ad infinitum.
 		if autoscalingOpts.FrequentLoopsEnabled {
 			// We need to have two timestamps because the scaleUp activity alternates between processing ProvisioningRequests,
@@ -143,24 +187,20 @@ func run(healthCheck *metrics.HealthCheck, debuggingSnapshotter debuggingsnapsho
 			for {
 				select {
 				case <-ctx.Done():
-					// Context is also passed down to RunOnce, so a long-running
-					// iteration in progress will be interrupted and cleaned up there.
 					return nil
 				default:
 					trigger.Wait(previousRun)
 					previousRun, lastRun = lastRun, time.Now()
-					loop.RunAutoscalerOnce(ctx, autoscaler, healthCheck, lastRun)
+					loop.RunAutoscalerOnce(iterationCtx, autoscaler, healthCheck, lastRun)
 				}
 			}
 		} else {
 			for {
 				select {
 				case <-ctx.Done():
-					// Context is also passed down to RunOnce, so a long-running
-					// iteration in progress will be interrupted and cleaned up there.
 					return nil
 				case <-time.After(autoscalingOpts.ScanInterval):
-					loop.RunAutoscalerOnce(ctx, autoscaler, healthCheck, time.Now())
+					loop.RunAutoscalerOnce(iterationCtx, autoscaler, healthCheck, time.Now())
 				}
 			}
 		}
@@ -169,9 +209,77 @@ func run(healthCheck *metrics.HealthCheck, debuggingSnapshotter debuggingsnapsho
 		klog.Fatalf("Failed to add runnable to manager: %v", err)
 	}
 
+	// The manager stops the runnables and releases the leader election lease when
+	// the context is cancelled, and fails if the leadership is lost.
 	if err := mgr.Start(ctx); err != nil {
 		klog.Fatalf("Manager exited with error: %v", err)
 	}
+	klog.V(1).Info("Manager stopped, exiting")
+}
+
+// newHTTPHandler returns the handler of the metrics, health check, debugging
+// snapshot and profiling endpoints.
+func newHTTPHandler(opts config.AutoscalingOptions, healthCheck *metrics.HealthCheck, cacheHealth *cacheHealthCheck, debuggingSnapshotter debuggingsnapshot.DebuggingSnapshotter) http.Handler {
+	pathRecorderMux := mux.NewPathRecorderMux("cluster-autoscaler")
+	defaultMetricsHandler := legacyregistry.Handler().ServeHTTP
+	pathRecorderMux.HandleFunc("/metrics", func(w http.ResponseWriter, req *http.Request) {
+		defaultMetricsHandler(w, req)
+	})
+	if opts.DebuggingSnapshotEnabled {
+		pathRecorderMux.HandleFunc("/snapshotz", debuggingSnapshotter.ResponseHandler)
+	}
+	pathRecorderMux.HandleFunc("/health-check", cacheHealth.wrap(healthCheck.ServeHTTP))
+	installHealthzHandler(pathRecorderMux, "/healthz", map[string]healthz.Checker{
+		"ping":      healthz.Ping,
+		"informers": cacheHealth.healthz,
+	})
+	installHealthzHandler(pathRecorderMux, "/readyz", map[string]healthz.Checker{
+		"ping":      healthz.Ping,
+		"informers": cacheHealth.readyz,
+	})
+	if opts.EnableProfiling {
+		routes.Profiling{}.Install(pathRecorderMux)
+	}
+	return pathRecorderMux
+}
+
+// installHealthzHandler serves the checks at the path the same way as the health
+// probe server of the manager, including the individual checks at subpaths.
+func installHealthzHandler(pathRecorderMux *mux.PathRecorderMux, path string, checks map[string]healthz.Checker) {
+	handler := http.StripPrefix(path, &healthz.Handler{Checks: checks})
+	pathRecorderMux.Handle(path, handler)
+	pathRecorderMux.HandlePrefix(path+"/", handler)
+}
+
+// crdObjects returns objects of the autoscaler CRDs used with the given options.
+func crdObjects(opts config.AutoscalingOptions) []client.Object {
+	var objects []client.Object
+	if opts.CapacityQuotasEnabled {
+		objects = append(objects, &cqv1beta1.CapacityQuota{})
+	}
+	if opts.CapacityBufferControllerEnabled || opts.CapacityBufferPodInjectionEnabled {
+		objects = append(objects, &cbv1beta1.CapacityBuffer{})
+	}
+	if opts.ProvisioningRequestEnabled {
+		objects = append(objects, &provreqv1.ProvisioningRequest{})
+	}
+	return objects
+}
+
+// ignoreScopedCapacityQuotas removes the limits of quotas with a scope from the
+// cached copies read by the quota enforcer. Capacity isn't attributed to pods yet,
+// so enforcing them would cap all scale-ups instead of the scoped ones. The
+// transform replaces the default one, so it strips managed fields as well.
+func ignoreScopedCapacityQuotas(obj interface{}) (interface{}, error) {
+	obj, err := cache.TransformStripManagedFields()(obj)
+	if err != nil {
+		return nil, err
+	}
+	if quota, ok := obj.(*cqv1beta1.CapacityQuota); ok && quota.Spec.Scope != nil {
+		quota.Spec.Limits.Resources = cqv1beta1.ResourceList{}
+		quota.Spec.Limits.Budget = nil
+	}
+	return obj, nil
 }
 
 func mustBuildAutoscaler(ctx context.Context, opts config.AutoscalingOptions, debuggingSnapshotter debuggingsnapshot.DebuggingSnapshotter, mgr manager.Manager) (core.Autoscaler, *loop.LoopTrigger) {
@@ -253,72 +361,9 @@ func main() {
 
 	debuggingSnapshotter := debuggingsnapshot.NewDebuggingSnapshotter(autoscalingOpts.DebuggingSnapshotEnabled)
 
-	go func() {
-		pathRecorderMux := mux.NewPathRecorderMux("cluster-autoscaler")
-		defaultMetricsHandler := legacyregistry.Handler().ServeHTTP
-		pathRecorderMux.HandleFunc("/metrics", func(w http.ResponseWriter, req *http.Request) {
-			defaultMetricsHandler(w, req)
-		})
-		if autoscalingOpts.DebuggingSnapshotEnabled {
-			pathRecorderMux.HandleFunc("/snapshotz", debuggingSnapshotter.ResponseHandler)
-		}
-		pathRecorderMux.HandleFunc("/health-check", healthCheck.ServeHTTP)
-		if autoscalingOpts.EnableProfiling {
-			routes.Profiling{}.Install(pathRecorderMux)
-		}
-		err := http.ListenAndServe(autoscalingOpts.Address, pathRecorderMux)
-		klog.Fatalf("Failed to start metrics: %v", err)
-	}()
-
-	if !leaderElection.LeaderElect {
-		run(healthCheck, debuggingSnapshotter)
-	} else {
-		id, err := os.Hostname()
-		if err != nil {
-			klog.Fatalf("Unable to get hostname: %v", err)
-		}
-
-		kubeClient := kube_util.CreateKubeClient(autoscalingOpts.KubeClientOpts)
-
-		// Validate that the client is ok.
-		_, err = kubeClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
-		if err != nil {
-			klog.Fatalf("Failed to get nodes from apiserver: %v", err)
-		}
-
-		lock, err := resourcelock.New(
-			leaderElection.ResourceLock,
-			autoscalingOpts.ConfigNamespace,
-			leaderElection.ResourceName,
-			kubeClient.CoreV1(),
-			kubeClient.CoordinationV1(),
-			resourcelock.ResourceLockConfig{
-				Identity:      id,
-				EventRecorder: kube_util.CreateEventRecorder(context.TODO(), kubeClient, autoscalingOpts.RecordDuplicatedEvents),
-			},
-		)
-		if err != nil {
-			klog.Fatalf("Unable to create leader election lock: %v", err)
-		}
-
-		leaderelection.RunOrDie(context.TODO(), leaderelection.LeaderElectionConfig{
-			Lock:            lock,
-			LeaseDuration:   leaderElection.LeaseDuration.Duration,
-			RenewDeadline:   leaderElection.RenewDeadline.Duration,
-			RetryPeriod:     leaderElection.RetryPeriod.Duration,
-			ReleaseOnCancel: true,
-			Callbacks: leaderelection.LeaderCallbacks{
-				OnStartedLeading: func(_ context.Context) {
-					// Since we are committing a suicide after losing
-					// mastership, we can safely ignore the argument.
-					run(healthCheck, debuggingSnapshotter)
-				},
-				OnStoppedLeading: func() {
-					klog.Fatalf("lost master")
-				},
-			},
-		})
-	}
+	// The context is cancelled on SIGTERM or SIGINT, which lets the autoscaler finish its
+	// current iteration and clean up. A second signal exits immediately.
+	run(ctrl.SetupSignalHandler(), leaderElection, healthCheck, debuggingSnapshotter)
 }
 
 func leaderElectionConfiguration() componentbaseconfig.LeaderElectionConfiguration {
@@ -336,4 +381,13 @@ const (
 	defaultLeaseDuration = 15 * time.Second
 	defaultRenewDeadline = 10 * time.Second
 	defaultRetryPeriod   = 2 * time.Second
+
+	// httpShutdownTimeout is how long in-flight requests can take after the
+	// autoscaler is stopped.
+	httpShutdownTimeout = 5 * time.Second
+	// gracefulShutdownTimeout is how long the iteration in progress and the cleanup
+	// can take on shutdown. It is below the default termination grace period of
+	// pods, 30s, so that the leader election lease is released before the pod is
+	// killed.
+	gracefulShutdownTimeout = 20 * time.Second
 )
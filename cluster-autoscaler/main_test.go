@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cqv1beta1 "k8s.io/autoscaler/cluster-autoscaler/apis/capacityquota/autoscaling.x-k8s.io/v1beta1"
+)
+
+func TestIgnoreScopedCapacityQuotas(t *testing.T) {
+	limits := func() cqv1beta1.CapacityQuotaLimits {
+		return cqv1beta1.CapacityQuotaLimits{
+			Resources: cqv1beta1.ResourceList{cqv1beta1.ResourceCPU: resource.MustParse("10")},
+			Budget: &cqv1beta1.CapacityQuotaBudget{
+				Resources: cqv1beta1.ResourceList{cqv1beta1.ResourceCPU: resource.MustParse("100")},
+			},
+		}
+	}
+	managedFields := []metav1.ManagedFieldsEntry{{Manager: "kubectl"}}
+
+	unscoped := &cqv1beta1.CapacityQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "unscoped", ManagedFields: managedFields},
+		Spec:       cqv1beta1.CapacityQuotaSpec{Limits: limits()},
+	}
+	obj, err := ignoreScopedCapacityQuotas(unscoped)
+	assert.NoError(t, err)
+	assert.Nil(t, obj.(*cqv1beta1.CapacityQuota).ManagedFields)
+	assert.Equal(t, limits(), obj.(*cqv1beta1.CapacityQuota).Spec.Limits)
+
+	scoped := &cqv1beta1.CapacityQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "scoped", ManagedFields: managedFields},
+		Spec: cqv1beta1.CapacityQuotaSpec{
+			Scope:  &cqv1beta1.CapacityQuotaScope{Namespaces: []string{"batch"}},
+			Limits: limits(),
+		},
+	}
+	obj, err = ignoreScopedCapacityQuotas(scoped)
+	assert.NoError(t, err)
+	assert.Nil(t, obj.(*cqv1beta1.CapacityQuota).ManagedFields)
+	assert.Equal(t, cqv1beta1.CapacityQuotaLimits{Resources: cqv1beta1.ResourceList{}}, obj.(*cqv1beta1.CapacityQuota).Spec.Limits)
+}